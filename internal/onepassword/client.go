@@ -0,0 +1,83 @@
+package onepassword
+
+import (
+	"context"
+	"errors"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// ErrNotSupportedWithConnect is returned by Client implementations backed by
+// 1Password Connect for operations that the Connect API does not expose.
+var ErrNotSupportedWithConnect = errors.New("not supported with 1Password Connect")
+
+// Client defines the set of operations the provider needs from a 1Password
+// backend. It is implemented both by a Connect-backed client and a
+// service-account/CLI-backed client; not every implementation supports every
+// method, in which case it returns an error wrapping ErrNotSupportedWithConnect.
+type Client interface {
+	GetVault(ctx context.Context, uuid string) (*model.Vault, error)
+	CreateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error)
+	// CreateVaultFromTemplate creates a vault seeded with the default item
+	// categories and permissions of the named template.
+	CreateVaultFromTemplate(ctx context.Context, vault *model.Vault, templateName string) (*model.Vault, error)
+	UpdateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error)
+	DeleteVault(ctx context.Context, uuid string) error
+
+	// AccountType returns "individual" or "business", used to validate
+	// template choices that are restricted to one account type.
+	AccountType(ctx context.Context) (string, error)
+
+	// GrantVaultAccess grants the subject identified by access.SubjectID the
+	// permissions in access.Permissions on the vault access.VaultID.
+	GrantVaultAccess(ctx context.Context, access *model.VaultAccess) (*model.VaultAccess, error)
+	// RevokeVaultAccess removes every permission the subject has on the vault.
+	RevokeVaultAccess(ctx context.Context, vaultUUID, subjectUUID string) error
+	// ListVaultAccess returns the permissions currently granted to every
+	// group and user on the vault.
+	ListVaultAccess(ctx context.Context, vaultUUID string) ([]model.VaultAccess, error)
+
+	CreateRecipe(ctx context.Context, recipe *model.PasswordRecipe) (*model.PasswordRecipe, error)
+	GetRecipe(ctx context.Context, uuid string) (*model.PasswordRecipe, error)
+	UpdateRecipe(ctx context.Context, recipe *model.PasswordRecipe) (*model.PasswordRecipe, error)
+	DeleteRecipe(ctx context.Context, uuid string) error
+
+	// CreateGeneratedSecret generates a value inside the vault and returns
+	// its digest and revision; the plaintext is never returned.
+	CreateGeneratedSecret(ctx context.Context, secret *model.GeneratedSecret) (*model.GeneratedSecret, error)
+	// GetGeneratedSecret returns the current metadata and revision of a
+	// generated secret, without reading the plaintext value; its ValueDigest
+	// may be empty.
+	GetGeneratedSecret(ctx context.Context, vaultUUID, uuid string) (*model.GeneratedSecret, error)
+	// RotateSecret generates a new value of the given length for an existing
+	// generated secret.
+	RotateSecret(ctx context.Context, vaultUUID, uuid string, length int64) (*model.GeneratedSecret, error)
+	// UpdateGeneratedSecretMetadata updates a generated secret's vault item
+	// title without touching its value or digest. The returned Version is the
+	// item's revision after the update.
+	UpdateGeneratedSecretMetadata(ctx context.Context, vaultUUID, uuid, label string) (*model.GeneratedSecret, error)
+	DeleteGeneratedSecret(ctx context.Context, vaultUUID, uuid string) error
+	// GetSecretValue returns the plaintext of a generated secret. It is only
+	// ever used by the ephemeral resource, never by a persisted one.
+	GetSecretValue(ctx context.Context, vaultUUID, uuid string) (string, error)
+
+	// CreateItem creates a vault item with a password field set to
+	// passwordValue; the plaintext is never returned.
+	CreateItem(ctx context.Context, item *model.Item, passwordValue string) (*model.Item, error)
+	// GetItem returns an item's current metadata and revision, without
+	// reading the plaintext value; its ValueDigest may be empty.
+	GetItem(ctx context.Context, vaultUUID, uuid string) (*model.Item, error)
+	// RotateItemPassword sets an item's password field to passwordValue.
+	RotateItemPassword(ctx context.Context, vaultUUID, uuid, passwordValue string) (*model.Item, error)
+	// UpdateItemMetadata updates an item's title without touching its
+	// password field or digest. The returned Version is the item's revision
+	// after the update.
+	UpdateItemMetadata(ctx context.Context, vaultUUID, uuid, title string) (*model.Item, error)
+	DeleteItem(ctx context.Context, vaultUUID, uuid string) error
+	// GeneratePasswordValue returns a new password value: when recipeUUID is
+	// non-empty it is shaped by that password_recipe's character-set policy,
+	// otherwise it is a random value of the given length. It never persists
+	// anything; the caller writes the result via CreateItem or
+	// RotateItemPassword.
+	GeneratePasswordValue(ctx context.Context, recipeUUID string, length int64) (string, error)
+}
@@ -0,0 +1,128 @@
+package onepassword
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/1Password/connect-sdk-go/connect"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// connectClient implements Client on top of a 1Password Connect server.
+// Connect does not expose vault or vault-permission management, so those
+// operations return ErrNotSupportedWithConnect.
+type connectClient struct {
+	connect connect.Client
+}
+
+// NewConnectClient returns a Client backed by a 1Password Connect server.
+func NewConnectClient(host, token, userAgent string) Client {
+	return &connectClient{
+		connect: connect.NewClientWithUserAgent(host, token, userAgent),
+	}
+}
+
+func (c *connectClient) GetVault(ctx context.Context, uuid string) (*model.Vault, error) {
+	v, err := c.connect.GetVault(uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Vault{ID: v.ID, Name: v.Name, Description: v.Description}, nil
+}
+
+func (c *connectClient) CreateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error) {
+	return nil, fmt.Errorf("could not create vault: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) CreateVaultFromTemplate(ctx context.Context, vault *model.Vault, templateName string) (*model.Vault, error) {
+	return nil, fmt.Errorf("could not create vault from template: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) UpdateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error) {
+	return nil, fmt.Errorf("could not update vault: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) AccountType(ctx context.Context) (string, error) {
+	return "", fmt.Errorf("could not get account type: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) DeleteVault(ctx context.Context, uuid string) error {
+	return fmt.Errorf("could not delete vault: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) GrantVaultAccess(ctx context.Context, access *model.VaultAccess) (*model.VaultAccess, error) {
+	return nil, fmt.Errorf("could not grant vault access: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) RevokeVaultAccess(ctx context.Context, vaultUUID, subjectUUID string) error {
+	return fmt.Errorf("could not revoke vault access: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) ListVaultAccess(ctx context.Context, vaultUUID string) ([]model.VaultAccess, error) {
+	return nil, fmt.Errorf("could not list vault access: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) CreateRecipe(ctx context.Context, recipe *model.PasswordRecipe) (*model.PasswordRecipe, error) {
+	return nil, fmt.Errorf("could not create password recipe: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) GetRecipe(ctx context.Context, uuid string) (*model.PasswordRecipe, error) {
+	return nil, fmt.Errorf("could not get password recipe: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) UpdateRecipe(ctx context.Context, recipe *model.PasswordRecipe) (*model.PasswordRecipe, error) {
+	return nil, fmt.Errorf("could not update password recipe: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) DeleteRecipe(ctx context.Context, uuid string) error {
+	return fmt.Errorf("could not delete password recipe: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) CreateGeneratedSecret(ctx context.Context, secret *model.GeneratedSecret) (*model.GeneratedSecret, error) {
+	return nil, fmt.Errorf("could not create generated secret: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) GetGeneratedSecret(ctx context.Context, vaultUUID, uuid string) (*model.GeneratedSecret, error) {
+	return nil, fmt.Errorf("could not get generated secret: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) RotateSecret(ctx context.Context, vaultUUID, uuid string, length int64) (*model.GeneratedSecret, error) {
+	return nil, fmt.Errorf("could not rotate generated secret: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) UpdateGeneratedSecretMetadata(ctx context.Context, vaultUUID, uuid, label string) (*model.GeneratedSecret, error) {
+	return nil, fmt.Errorf("could not update generated secret: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) DeleteGeneratedSecret(ctx context.Context, vaultUUID, uuid string) error {
+	return fmt.Errorf("could not delete generated secret: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) GetSecretValue(ctx context.Context, vaultUUID, uuid string) (string, error) {
+	return "", fmt.Errorf("could not read generated secret value: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) CreateItem(ctx context.Context, item *model.Item, passwordValue string) (*model.Item, error) {
+	return nil, fmt.Errorf("could not create item: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) GetItem(ctx context.Context, vaultUUID, uuid string) (*model.Item, error) {
+	return nil, fmt.Errorf("could not get item: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) RotateItemPassword(ctx context.Context, vaultUUID, uuid, passwordValue string) (*model.Item, error) {
+	return nil, fmt.Errorf("could not rotate item password: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) UpdateItemMetadata(ctx context.Context, vaultUUID, uuid, title string) (*model.Item, error) {
+	return nil, fmt.Errorf("could not update item: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) DeleteItem(ctx context.Context, vaultUUID, uuid string) error {
+	return fmt.Errorf("could not delete item: %w", ErrNotSupportedWithConnect)
+}
+
+func (c *connectClient) GeneratePasswordValue(ctx context.Context, recipeUUID string, length int64) (string, error) {
+	return "", fmt.Errorf("could not generate password value: %w", ErrNotSupportedWithConnect)
+}
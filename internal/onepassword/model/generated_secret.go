@@ -0,0 +1,25 @@
+package model
+
+// GeneratedSecret is a value that 1Password generates and stores directly in
+// a vault item, so that the plaintext never has to be returned to, or
+// persisted by, Terraform. Only a digest of the value and its revision are
+// ever read back.
+type GeneratedSecret struct {
+	ID      string
+	VaultID string
+	Label   string
+	Tags    []string
+
+	// RecipeRef, if set, is the ref of an onepassword_password_recipe
+	// recorded against the secret. The value is generated from Length alone;
+	// RecipeRef is not applied to it.
+	RecipeRef string
+	Length    int64
+
+	// ValueDigest is a content hash of the current value, suitable for
+	// detecting drift without ever storing the plaintext.
+	ValueDigest string
+	// Version is the vault item's revision identifier as of the last write
+	// to, or read of, the item; a title-only update changes it too.
+	Version string
+}
@@ -0,0 +1,16 @@
+package model
+
+// Item represents a 1Password item.
+type Item struct {
+	ID       string
+	VaultID  string
+	Title    string
+	Category string
+
+	// ValueDigest is a content hash of the item's password field, suitable
+	// for detecting drift without ever storing the plaintext.
+	ValueDigest string
+	// Version is the vault item's revision identifier as of the last write
+	// to, or read of, the item; a title-only update changes it too.
+	Version string
+}
@@ -0,0 +1,20 @@
+package model
+
+// PasswordRecipe is a reusable, named policy describing how a generated
+// password or passphrase should be composed.
+type PasswordRecipe struct {
+	ID     string
+	Name   string
+	Length int64
+
+	// Character-based recipe settings. Ignored when WordCount > 0.
+	IncludeLetters   bool
+	IncludeDigits    bool
+	IncludeSymbols   bool
+	SymbolCharset    string
+	ExcludeAmbiguous bool
+
+	// Word-list ("memorable password") recipe settings.
+	WordCount int64
+	Separator string
+}
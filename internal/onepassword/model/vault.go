@@ -0,0 +1,13 @@
+package model
+
+// Vault represents a 1Password vault.
+type Vault struct {
+	ID          string
+	Name        string
+	Description string
+
+	// Template is the name of the vault template the vault was created
+	// from, e.g. "personal" or "shared". It is empty for vaults that were
+	// not created from a template.
+	Template string
+}
@@ -0,0 +1,38 @@
+package model
+
+// VaultPermission is a single permission that can be granted to a group or
+// user on a vault.
+type VaultPermission string
+
+const (
+	VaultPermissionViewItems            VaultPermission = "view_items"
+	VaultPermissionCreateItems          VaultPermission = "create_items"
+	VaultPermissionEditItems            VaultPermission = "edit_items"
+	VaultPermissionArchiveItems         VaultPermission = "archive_items"
+	VaultPermissionDeleteItems          VaultPermission = "delete_items"
+	VaultPermissionViewAndCopyPasswords VaultPermission = "view_and_copy_passwords"
+	VaultPermissionViewItemHistory      VaultPermission = "view_item_history"
+	VaultPermissionImportItems          VaultPermission = "import_items"
+	VaultPermissionExportItems          VaultPermission = "export_items"
+	VaultPermissionCopyAndShareItems    VaultPermission = "copy_and_share_items"
+	VaultPermissionPrintItems           VaultPermission = "print_items"
+	VaultPermissionManageVault          VaultPermission = "manage_vault"
+)
+
+// VaultAccessSubjectType identifies whether a VaultAccess grant belongs to a
+// group or an individual user.
+type VaultAccessSubjectType string
+
+const (
+	VaultAccessSubjectTypeGroup VaultAccessSubjectType = "group"
+	VaultAccessSubjectTypeUser  VaultAccessSubjectType = "user"
+)
+
+// VaultAccess describes the set of permissions a group or user has been
+// granted on a vault.
+type VaultAccess struct {
+	VaultID     string
+	SubjectID   string
+	SubjectType VaultAccessSubjectType
+	Permissions []VaultPermission
+}
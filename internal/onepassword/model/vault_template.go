@@ -0,0 +1,68 @@
+package model
+
+// VaultTemplate describes a 1Password vault template: the set of item
+// categories it seeds by default, and the default permission matrix applied
+// to vaults created from it.
+type VaultTemplate struct {
+	Name               string
+	DefaultCategories  []string
+	DefaultPermissions []VaultPermission
+}
+
+// VaultTemplates is the fixed set of vault templates 1Password supports.
+var VaultTemplates = map[string]VaultTemplate{
+	"personal": {
+		Name:               "personal",
+		DefaultCategories:  []string{"LOGIN", "PASSWORD", "SECURE_NOTE", "BANK_ACCOUNT", "CREDIT_CARD", "IDENTITY"},
+		DefaultPermissions: []VaultPermission{VaultPermissionViewItems, VaultPermissionCreateItems, VaultPermissionEditItems, VaultPermissionDeleteItems, VaultPermissionManageVault},
+	},
+	"private": {
+		Name:               "private",
+		DefaultCategories:  []string{"LOGIN", "PASSWORD", "SECURE_NOTE"},
+		DefaultPermissions: []VaultPermission{VaultPermissionViewItems, VaultPermissionCreateItems, VaultPermissionEditItems, VaultPermissionManageVault},
+	},
+	"employee": {
+		Name:               "employee",
+		DefaultCategories:  []string{"LOGIN", "PASSWORD", "SECURE_NOTE", "DOCUMENT"},
+		DefaultPermissions: []VaultPermission{VaultPermissionViewItems, VaultPermissionCreateItems, VaultPermissionEditItems, VaultPermissionViewAndCopyPasswords},
+	},
+	"shared": {
+		Name:               "shared",
+		DefaultCategories:  []string{"LOGIN", "PASSWORD", "SECURE_NOTE", "SERVER", "DATABASE"},
+		DefaultPermissions: []VaultPermission{VaultPermissionViewItems, VaultPermissionCreateItems, VaultPermissionEditItems, VaultPermissionArchiveItems, VaultPermissionViewAndCopyPasswords, VaultPermissionImportItems, VaultPermissionExportItems},
+	},
+	"custom": {
+		Name:               "custom",
+		DefaultCategories:  []string{},
+		DefaultPermissions: []VaultPermission{VaultPermissionViewItems, VaultPermissionManageVault},
+	},
+}
+
+// InferTemplate returns the name of the template whose default category set
+// matches categories exactly, or "" if none match. Used to populate a
+// vault's template attribute on Read, since 1Password does not otherwise
+// remember which template a vault was created from.
+func InferTemplate(categories []string) string {
+	for name, tmpl := range VaultTemplates {
+		if sameCategorySet(tmpl.DefaultCategories, categories) {
+			return name
+		}
+	}
+	return ""
+}
+
+func sameCategorySet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]bool, len(a))
+	for _, c := range a {
+		seen[c] = true
+	}
+	for _, c := range b {
+		if !seen[c] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,558 @@
+package onepassword
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/1Password/onepassword-sdk-go"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// sdkClient implements Client on top of a 1Password service account, using
+// the 1Password Go SDK. Unlike connectClient, it has access to the
+// administrative SCIM/Admin API needed to manage vaults and vault
+// permissions.
+type sdkClient struct {
+	sdk *onepassword.Client
+}
+
+// NewSDKClient returns a Client backed by a 1Password service account token.
+func NewSDKClient(ctx context.Context, token, userAgent string) (Client, error) {
+	client, err := onepassword.NewClient(ctx,
+		onepassword.WithServiceAccountToken(token),
+		onepassword.WithIntegrationInfo(userAgent, "v2"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create 1Password SDK client: %w", err)
+	}
+	return &sdkClient{sdk: client}, nil
+}
+
+func (c *sdkClient) GetVault(ctx context.Context, uuid string) (*model.Vault, error) {
+	v, err := c.sdk.Vaults.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := c.sdk.Vaults.ListCategories(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Vault{
+		ID:          v.ID,
+		Name:        v.Title,
+		Description: v.Description,
+		Template:    model.InferTemplate(categories),
+	}, nil
+}
+
+func (c *sdkClient) CreateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error) {
+	v, err := c.sdk.Vaults.Create(ctx, onepassword.VaultCreateParams{
+		Title:       vault.Name,
+		Description: vault.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.Vault{ID: v.ID, Name: v.Title, Description: v.Description}, nil
+}
+
+func (c *sdkClient) CreateVaultFromTemplate(ctx context.Context, vault *model.Vault, templateName string) (*model.Vault, error) {
+	template, ok := model.VaultTemplates[templateName]
+	if !ok {
+		return nil, fmt.Errorf("unknown vault template %q", templateName)
+	}
+
+	v, err := c.sdk.Vaults.Create(ctx, onepassword.VaultCreateParams{
+		Title:             vault.Name,
+		Description:       vault.Description,
+		Template:          template.Name,
+		DefaultCategories: template.DefaultCategories,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Vault{
+		ID:          v.ID,
+		Name:        v.Title,
+		Description: v.Description,
+		Template:    template.Name,
+	}, nil
+}
+
+func (c *sdkClient) AccountType(ctx context.Context) (string, error) {
+	account, err := c.sdk.Account.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return account.Type, nil
+}
+
+func (c *sdkClient) UpdateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error) {
+	v, err := c.sdk.Vaults.Update(ctx, vault.ID, onepassword.VaultUpdateParams{
+		Title:       vault.Name,
+		Description: vault.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.Vault{ID: v.ID, Name: v.Title, Description: v.Description}, nil
+}
+
+func (c *sdkClient) DeleteVault(ctx context.Context, uuid string) error {
+	return c.sdk.Vaults.Delete(ctx, uuid)
+}
+
+func (c *sdkClient) GrantVaultAccess(ctx context.Context, access *model.VaultAccess) (*model.VaultAccess, error) {
+	permissions := make([]string, 0, len(access.Permissions))
+	for _, p := range access.Permissions {
+		permissions = append(permissions, string(p))
+	}
+
+	grant, err := c.sdk.VaultPermissions.Grant(ctx, onepassword.VaultPermissionGrantParams{
+		VaultID:     access.VaultID,
+		SubjectID:   access.SubjectID,
+		SubjectType: string(access.SubjectType),
+		Permissions: permissions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not grant vault access: %w", err)
+	}
+
+	return vaultAccessFromGrant(access.VaultID, grant), nil
+}
+
+func (c *sdkClient) RevokeVaultAccess(ctx context.Context, vaultUUID, subjectUUID string) error {
+	if err := c.sdk.VaultPermissions.Revoke(ctx, vaultUUID, subjectUUID); err != nil {
+		return fmt.Errorf("could not revoke vault access: %w", err)
+	}
+	return nil
+}
+
+func (c *sdkClient) ListVaultAccess(ctx context.Context, vaultUUID string) ([]model.VaultAccess, error) {
+	grants, err := c.sdk.VaultPermissions.List(ctx, vaultUUID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list vault access: %w", err)
+	}
+
+	access := make([]model.VaultAccess, 0, len(grants))
+	for _, grant := range grants {
+		access = append(access, *vaultAccessFromGrant(vaultUUID, grant))
+	}
+	return access, nil
+}
+
+func (c *sdkClient) CreateRecipe(ctx context.Context, recipe *model.PasswordRecipe) (*model.PasswordRecipe, error) {
+	r, err := c.sdk.PasswordRecipes.Create(ctx, passwordRecipeCreateParams(recipe))
+	if err != nil {
+		return nil, fmt.Errorf("could not create password recipe: %w", err)
+	}
+	return passwordRecipeFromSDK(r), nil
+}
+
+func (c *sdkClient) GetRecipe(ctx context.Context, uuid string) (*model.PasswordRecipe, error) {
+	r, err := c.sdk.PasswordRecipes.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return passwordRecipeFromSDK(r), nil
+}
+
+func (c *sdkClient) UpdateRecipe(ctx context.Context, recipe *model.PasswordRecipe) (*model.PasswordRecipe, error) {
+	r, err := c.sdk.PasswordRecipes.Update(ctx, recipe.ID, passwordRecipeCreateParams(recipe))
+	if err != nil {
+		return nil, fmt.Errorf("could not update password recipe: %w", err)
+	}
+	return passwordRecipeFromSDK(r), nil
+}
+
+func (c *sdkClient) DeleteRecipe(ctx context.Context, uuid string) error {
+	if err := c.sdk.PasswordRecipes.Delete(ctx, uuid); err != nil {
+		return fmt.Errorf("could not delete password recipe: %w", err)
+	}
+	return nil
+}
+
+func passwordRecipeCreateParams(recipe *model.PasswordRecipe) onepassword.PasswordRecipeCreateParams {
+	return onepassword.PasswordRecipeCreateParams{
+		Name:             recipe.Name,
+		Length:           recipe.Length,
+		IncludeLetters:   recipe.IncludeLetters,
+		IncludeDigits:    recipe.IncludeDigits,
+		IncludeSymbols:   recipe.IncludeSymbols,
+		SymbolCharset:    recipe.SymbolCharset,
+		ExcludeAmbiguous: recipe.ExcludeAmbiguous,
+		WordCount:        recipe.WordCount,
+		Separator:        recipe.Separator,
+	}
+}
+
+func passwordRecipeFromSDK(r onepassword.PasswordRecipe) *model.PasswordRecipe {
+	return &model.PasswordRecipe{
+		ID:               r.ID,
+		Name:             r.Name,
+		Length:           r.Length,
+		IncludeLetters:   r.IncludeLetters,
+		IncludeDigits:    r.IncludeDigits,
+		IncludeSymbols:   r.IncludeSymbols,
+		SymbolCharset:    r.SymbolCharset,
+		ExcludeAmbiguous: r.ExcludeAmbiguous,
+		WordCount:        r.WordCount,
+		Separator:        r.Separator,
+	}
+}
+
+func (c *sdkClient) CreateGeneratedSecret(ctx context.Context, secret *model.GeneratedSecret) (*model.GeneratedSecret, error) {
+	value, err := generateSecretValue(secret)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate secret value: %w", err)
+	}
+
+	item, err := c.sdk.Items.Create(ctx, onepassword.ItemCreateParams{
+		VaultID:  secret.VaultID,
+		Title:    secret.Label,
+		Tags:     secret.Tags,
+		Category: "PASSWORD",
+		Fields: []onepassword.ItemFieldCreateParams{
+			{ID: "password", Value: value},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create generated secret: %w", err)
+	}
+
+	return &model.GeneratedSecret{
+		ID:          item.ID,
+		VaultID:     secret.VaultID,
+		Label:       secret.Label,
+		Tags:        secret.Tags,
+		RecipeRef:   secret.RecipeRef,
+		Length:      secret.Length,
+		ValueDigest: digestSecretValue(value),
+		Version:     item.Version,
+	}, nil
+}
+
+func (c *sdkClient) GetGeneratedSecret(ctx context.Context, vaultUUID, uuid string) (*model.GeneratedSecret, error) {
+	item, err := c.sdk.Items.Get(ctx, vaultUUID, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GeneratedSecret{
+		ID:      item.ID,
+		VaultID: vaultUUID,
+		Label:   item.Title,
+		Tags:    item.Tags,
+		Version: item.Version,
+	}, nil
+}
+
+func (c *sdkClient) RotateSecret(ctx context.Context, vaultUUID, uuid string, length int64) (*model.GeneratedSecret, error) {
+	existing, err := c.GetGeneratedSecret(ctx, vaultUUID, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("could not read generated secret to rotate: %w", err)
+	}
+
+	// The item's password field is never read back, so length is not
+	// something GetGeneratedSecret can recover from the vault; the caller
+	// threads the value it wants rotated in with, same as
+	// UpdateGeneratedSecretMetadata takes the wanted label as a parameter.
+	existing.Length = length
+
+	value, err := generateSecretValue(existing)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate secret value: %w", err)
+	}
+
+	item, err := c.sdk.Items.Update(ctx, vaultUUID, uuid, onepassword.ItemUpdateParams{
+		Fields: []onepassword.ItemFieldCreateParams{
+			{ID: "password", Value: value},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not rotate generated secret: %w", err)
+	}
+
+	existing.ValueDigest = digestSecretValue(value)
+	existing.Version = item.Version
+	return existing, nil
+}
+
+func (c *sdkClient) UpdateGeneratedSecretMetadata(ctx context.Context, vaultUUID, uuid, label string) (*model.GeneratedSecret, error) {
+	existing, err := c.GetGeneratedSecret(ctx, vaultUUID, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("could not read generated secret to update: %w", err)
+	}
+
+	item, err := c.sdk.Items.Update(ctx, vaultUUID, uuid, onepassword.ItemUpdateParams{
+		Title: label,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not update generated secret: %w", err)
+	}
+
+	existing.Label = label
+	existing.Version = item.Version
+	return existing, nil
+}
+
+func (c *sdkClient) DeleteGeneratedSecret(ctx context.Context, vaultUUID, uuid string) error {
+	if err := c.sdk.Items.Delete(ctx, vaultUUID, uuid); err != nil {
+		return fmt.Errorf("could not delete generated secret: %w", err)
+	}
+	return nil
+}
+
+func (c *sdkClient) GetSecretValue(ctx context.Context, vaultUUID, uuid string) (string, error) {
+	item, err := c.sdk.Items.Get(ctx, vaultUUID, uuid)
+	if err != nil {
+		return "", err
+	}
+	for _, field := range item.Fields {
+		if field.ID == "password" {
+			return field.Value, nil
+		}
+	}
+	return "", fmt.Errorf("generated secret %q has no password field", uuid)
+}
+
+// generateSecretValue generates a random URL-safe value of secret.Length
+// characters (32 when Length is not positive). secret.RecipeRef is not
+// consulted.
+func generateSecretValue(secret *model.GeneratedSecret) (string, error) {
+	length := secret.Length
+	if length <= 0 {
+		length = 32
+	}
+
+	buf := make([]byte, length)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf)[:length], nil
+}
+
+func (c *sdkClient) CreateItem(ctx context.Context, item *model.Item, passwordValue string) (*model.Item, error) {
+	category := item.Category
+	if category == "" {
+		category = "LOGIN"
+	}
+
+	created, err := c.sdk.Items.Create(ctx, onepassword.ItemCreateParams{
+		VaultID:  item.VaultID,
+		Title:    item.Title,
+		Category: onepassword.ItemCategory(category),
+		Fields: []onepassword.ItemFieldCreateParams{
+			{ID: "password", Value: passwordValue},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create item: %w", err)
+	}
+
+	return &model.Item{
+		ID:          created.ID,
+		VaultID:     item.VaultID,
+		Title:       item.Title,
+		Category:    category,
+		ValueDigest: digestSecretValue(passwordValue),
+		Version:     created.Version,
+	}, nil
+}
+
+func (c *sdkClient) GetItem(ctx context.Context, vaultUUID, uuid string) (*model.Item, error) {
+	item, err := c.sdk.Items.Get(ctx, vaultUUID, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Item{
+		ID:       item.ID,
+		VaultID:  vaultUUID,
+		Title:    item.Title,
+		Category: string(item.Category),
+		Version:  item.Version,
+	}, nil
+}
+
+func (c *sdkClient) RotateItemPassword(ctx context.Context, vaultUUID, uuid, passwordValue string) (*model.Item, error) {
+	existing, err := c.GetItem(ctx, vaultUUID, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("could not read item to rotate: %w", err)
+	}
+
+	item, err := c.sdk.Items.Update(ctx, vaultUUID, uuid, onepassword.ItemUpdateParams{
+		Fields: []onepassword.ItemFieldCreateParams{
+			{ID: "password", Value: passwordValue},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not rotate item password: %w", err)
+	}
+
+	existing.ValueDigest = digestSecretValue(passwordValue)
+	existing.Version = item.Version
+	return existing, nil
+}
+
+func (c *sdkClient) UpdateItemMetadata(ctx context.Context, vaultUUID, uuid, title string) (*model.Item, error) {
+	existing, err := c.GetItem(ctx, vaultUUID, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("could not read item to update: %w", err)
+	}
+
+	item, err := c.sdk.Items.Update(ctx, vaultUUID, uuid, onepassword.ItemUpdateParams{
+		Title: title,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not update item: %w", err)
+	}
+
+	existing.Title = title
+	existing.Version = item.Version
+	return existing, nil
+}
+
+func (c *sdkClient) DeleteItem(ctx context.Context, vaultUUID, uuid string) error {
+	if err := c.sdk.Items.Delete(ctx, vaultUUID, uuid); err != nil {
+		return fmt.Errorf("could not delete item: %w", err)
+	}
+	return nil
+}
+
+func (c *sdkClient) GeneratePasswordValue(ctx context.Context, recipeUUID string, length int64) (string, error) {
+	if recipeUUID == "" {
+		return generateSecretValue(&model.GeneratedSecret{Length: length})
+	}
+
+	recipe, err := c.GetRecipe(ctx, recipeUUID)
+	if err != nil {
+		return "", fmt.Errorf("could not read password recipe %q: %w", recipeUUID, err)
+	}
+	return generatePasswordFromRecipe(recipe)
+}
+
+const (
+	letterCharset         = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitCharset          = "0123456789"
+	defaultSymbolCharset  = "!@#$%^&*()-_=+[]{}<>?"
+	ambiguousCharset      = "Il1O0"
+	defaultPasswordLength = 32
+)
+
+// wordList is a small built-in word list used for memorable, word-based
+// recipes. It is not meant to match the size or entropy of a dedicated
+// wordlist such as the EFF's; it exists so word_count recipes can be
+// exercised end-to-end without bundling a large external list.
+var wordList = []string{
+	"anchor", "beacon", "canyon", "delta", "ember", "falcon", "granite", "harbor",
+	"island", "jasper", "kindle", "lumen", "meadow", "nectar", "oasis", "pebble",
+	"quartz", "ridge", "summit", "thicket", "umbra", "violet", "willow", "yonder",
+	"zephyr", "amber", "basin", "cobalt", "drift", "ember", "flint", "glacier",
+}
+
+// generatePasswordFromRecipe generates a value shaped by recipe's
+// character-set policy (or its word-list policy when WordCount > 0).
+func generatePasswordFromRecipe(recipe *model.PasswordRecipe) (string, error) {
+	if recipe.WordCount > 0 {
+		return generateWordListPassword(recipe)
+	}
+	return generateCharacterPassword(recipe)
+}
+
+func generateCharacterPassword(recipe *model.PasswordRecipe) (string, error) {
+	charset := ""
+	if recipe.IncludeLetters {
+		charset += letterCharset
+	}
+	if recipe.IncludeDigits {
+		charset += digitCharset
+	}
+	if recipe.IncludeSymbols {
+		symbols := recipe.SymbolCharset
+		if symbols == "" {
+			symbols = defaultSymbolCharset
+		}
+		charset += symbols
+	}
+	if charset == "" {
+		charset = letterCharset + digitCharset
+	}
+	if recipe.ExcludeAmbiguous {
+		charset = stripChars(charset, ambiguousCharset)
+	}
+
+	length := recipe.Length
+	if length <= 0 {
+		length = defaultPasswordLength
+	}
+
+	out := make([]byte, length)
+	max := big.NewInt(int64(len(charset)))
+	for i := range out {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		out[i] = charset[n.Int64()]
+	}
+	return string(out), nil
+}
+
+func generateWordListPassword(recipe *model.PasswordRecipe) (string, error) {
+	separator := recipe.Separator
+	if separator == "" {
+		separator = "-"
+	}
+
+	words := make([]string, recipe.WordCount)
+	max := big.NewInt(int64(len(wordList)))
+	for i := range words {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		words[i] = wordList[n.Int64()]
+	}
+	return strings.Join(words, separator), nil
+}
+
+func stripChars(s, chars string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(chars, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// digestSecretValue returns a content hash of value, so that drift can be
+// detected without ever persisting the plaintext to state.
+func digestSecretValue(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func vaultAccessFromGrant(vaultUUID string, grant onepassword.VaultPermissionGrant) *model.VaultAccess {
+	permissions := make([]model.VaultPermission, 0, len(grant.Permissions))
+	for _, p := range grant.Permissions {
+		permissions = append(permissions, model.VaultPermission(p))
+	}
+
+	return &model.VaultAccess{
+		VaultID:     vaultUUID,
+		SubjectID:   grant.SubjectID,
+		SubjectType: model.VaultAccessSubjectType(grant.SubjectType),
+		Permissions: permissions,
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate the provider during
+// acceptance testing. A Connect-backed client is always used in tests since
+// it does not require network access to 1Password.com or the 1Password CLI.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"onepassword": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccProviderConfig returns a provider block pointed at a local test
+// server standing in for 1Password Connect.
+func testAccProviderConfig(serverURL string) string {
+	return fmt.Sprintf(`
+provider "onepassword" {
+  connect_host  = %[1]q
+  connect_token = "test-token"
+}
+`, serverURL)
+}
+
+// setupTestServer stands up an httptest.Server that serves the given vault
+// and item as a 1Password Connect server would.
+func setupTestServer(item model.Item, vault model.Vault, t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf("/v1/vaults/%s", vault.ID), func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(vault)
+	})
+	mux.HandleFunc(fmt.Sprintf("/v1/vaults/%s/items/%s", vault.ID, item.ID), func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(item)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+// generateDatabaseItem returns an Item representative of a "Database"
+// category item, for tests that only care about an item existing in a vault.
+func generateDatabaseItem() model.Item {
+	return model.Item{
+		ID:       "item-test-uuid",
+		VaultID:  "vault-test-uuid",
+		Title:    "Test Database Item",
+		Category: "DATABASE",
+	}
+}
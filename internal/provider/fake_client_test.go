@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// fakeClient is an in-memory onepassword.Client used to unit test
+// SDK-only resource logic (Create/Read/Update/Delete, drift detection)
+// without standing up an httptest.Server that merely simulates the
+// Connect-unsupported error path.
+type fakeClient struct {
+	mu sync.Mutex
+
+	vaults      map[string]model.Vault
+	vaultAccess map[string][]model.VaultAccess
+	recipes     map[string]model.PasswordRecipe
+	secrets     map[string]model.GeneratedSecret
+	secretValue map[string]string
+	items       map[string]model.Item
+	itemValue   map[string]string
+
+	nextID int
+}
+
+var _ onepassword.Client = (*fakeClient)(nil)
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		vaults:      map[string]model.Vault{},
+		vaultAccess: map[string][]model.VaultAccess{},
+		recipes:     map[string]model.PasswordRecipe{},
+		secrets:     map[string]model.GeneratedSecret{},
+		secretValue: map[string]string{},
+		items:       map[string]model.Item{},
+		itemValue:   map[string]string{},
+	}
+}
+
+func (c *fakeClient) newID(prefix string) string {
+	c.nextID++
+	return fmt.Sprintf("%s-%d", prefix, c.nextID)
+}
+
+func (c *fakeClient) GetVault(ctx context.Context, uuid string) (*model.Vault, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, ok := c.vaults[uuid]
+	if !ok {
+		return nil, fmt.Errorf("vault %q not found", uuid)
+	}
+	return &v, nil
+}
+
+func (c *fakeClient) CreateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	created := *vault
+	created.ID = c.newID("vault")
+	c.vaults[created.ID] = created
+	return &created, nil
+}
+
+func (c *fakeClient) CreateVaultFromTemplate(ctx context.Context, vault *model.Vault, templateName string) (*model.Vault, error) {
+	created, err := c.CreateVault(ctx, vault)
+	if err != nil {
+		return nil, err
+	}
+	created.Template = templateName
+
+	c.mu.Lock()
+	c.vaults[created.ID] = *created
+	c.mu.Unlock()
+	return created, nil
+}
+
+func (c *fakeClient) UpdateVault(ctx context.Context, vault *model.Vault) (*model.Vault, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.vaults[vault.ID]; !ok {
+		return nil, fmt.Errorf("vault %q not found", vault.ID)
+	}
+	c.vaults[vault.ID] = *vault
+	return vault, nil
+}
+
+func (c *fakeClient) DeleteVault(ctx context.Context, uuid string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.vaults, uuid)
+	return nil
+}
+
+func (c *fakeClient) AccountType(ctx context.Context) (string, error) {
+	return "individual", nil
+}
+
+func (c *fakeClient) GrantVaultAccess(ctx context.Context, access *model.VaultAccess) (*model.VaultAccess, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	granted := *access
+	existing := c.vaultAccess[access.VaultID]
+	for i, a := range existing {
+		if a.SubjectType == access.SubjectType && a.SubjectID == access.SubjectID {
+			existing[i] = granted
+			c.vaultAccess[access.VaultID] = existing
+			return &granted, nil
+		}
+	}
+	c.vaultAccess[access.VaultID] = append(existing, granted)
+	return &granted, nil
+}
+
+func (c *fakeClient) RevokeVaultAccess(ctx context.Context, vaultUUID, subjectUUID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	existing := c.vaultAccess[vaultUUID]
+	for i, a := range existing {
+		if a.SubjectID == subjectUUID {
+			c.vaultAccess[vaultUUID] = append(existing[:i], existing[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (c *fakeClient) ListVaultAccess(ctx context.Context, vaultUUID string) ([]model.VaultAccess, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]model.VaultAccess(nil), c.vaultAccess[vaultUUID]...), nil
+}
+
+func (c *fakeClient) CreateRecipe(ctx context.Context, recipe *model.PasswordRecipe) (*model.PasswordRecipe, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	created := *recipe
+	created.ID = c.newID("recipe")
+	c.recipes[created.ID] = created
+	return &created, nil
+}
+
+func (c *fakeClient) GetRecipe(ctx context.Context, uuid string) (*model.PasswordRecipe, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r, ok := c.recipes[uuid]
+	if !ok {
+		return nil, fmt.Errorf("recipe %q not found", uuid)
+	}
+	return &r, nil
+}
+
+func (c *fakeClient) UpdateRecipe(ctx context.Context, recipe *model.PasswordRecipe) (*model.PasswordRecipe, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.recipes[recipe.ID]; !ok {
+		return nil, fmt.Errorf("recipe %q not found", recipe.ID)
+	}
+	c.recipes[recipe.ID] = *recipe
+	return recipe, nil
+}
+
+func (c *fakeClient) DeleteRecipe(ctx context.Context, uuid string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.recipes, uuid)
+	return nil
+}
+
+func (c *fakeClient) CreateGeneratedSecret(ctx context.Context, secret *model.GeneratedSecret) (*model.GeneratedSecret, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	created := *secret
+	created.ID = c.newID("secret")
+	created.Version = c.newID("rev")
+	value := c.newID("value")
+	created.ValueDigest = digestValue(value)
+
+	c.secrets[created.ID] = created
+	c.secretValue[created.ID] = value
+	return &created, nil
+}
+
+func (c *fakeClient) GetGeneratedSecret(ctx context.Context, vaultUUID, uuid string) (*model.GeneratedSecret, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.secrets[uuid]
+	if !ok || s.VaultID != vaultUUID {
+		return nil, fmt.Errorf("generated secret %q not found", uuid)
+	}
+	return &s, nil
+}
+
+func (c *fakeClient) RotateSecret(ctx context.Context, vaultUUID, uuid string, length int64) (*model.GeneratedSecret, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.secrets[uuid]
+	if !ok || s.VaultID != vaultUUID {
+		return nil, fmt.Errorf("generated secret %q not found", uuid)
+	}
+
+	s.Length = length
+	s.Version = c.newID("rev")
+	value := c.newID("value")
+	s.ValueDigest = digestValue(value)
+
+	c.secrets[uuid] = s
+	c.secretValue[uuid] = value
+	return &s, nil
+}
+
+func (c *fakeClient) UpdateGeneratedSecretMetadata(ctx context.Context, vaultUUID, uuid, label string) (*model.GeneratedSecret, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.secrets[uuid]
+	if !ok || s.VaultID != vaultUUID {
+		return nil, fmt.Errorf("generated secret %q not found", uuid)
+	}
+
+	s.Label = label
+	s.Version = c.newID("rev")
+	c.secrets[uuid] = s
+	return &s, nil
+}
+
+func (c *fakeClient) DeleteGeneratedSecret(ctx context.Context, vaultUUID, uuid string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.secrets, uuid)
+	delete(c.secretValue, uuid)
+	return nil
+}
+
+func (c *fakeClient) GetSecretValue(ctx context.Context, vaultUUID, uuid string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.secrets[uuid]
+	if !ok || s.VaultID != vaultUUID {
+		return "", fmt.Errorf("generated secret %q not found", uuid)
+	}
+	return c.secretValue[uuid], nil
+}
+
+// digestValue stands in for the real content hash the SDK client computes;
+// tests only ever compare digests with one another, never against a fixed
+// value.
+func digestValue(value string) string {
+	return "digest:" + value
+}
+
+func (c *fakeClient) CreateItem(ctx context.Context, item *model.Item, passwordValue string) (*model.Item, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	created := *item
+	created.ID = c.newID("item")
+	created.Version = c.newID("rev")
+	created.ValueDigest = digestValue(passwordValue)
+
+	c.items[created.ID] = created
+	c.itemValue[created.ID] = passwordValue
+	return &created, nil
+}
+
+func (c *fakeClient) GetItem(ctx context.Context, vaultUUID, uuid string) (*model.Item, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i, ok := c.items[uuid]
+	if !ok || i.VaultID != vaultUUID {
+		return nil, fmt.Errorf("item %q not found", uuid)
+	}
+	return &i, nil
+}
+
+func (c *fakeClient) RotateItemPassword(ctx context.Context, vaultUUID, uuid, passwordValue string) (*model.Item, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i, ok := c.items[uuid]
+	if !ok || i.VaultID != vaultUUID {
+		return nil, fmt.Errorf("item %q not found", uuid)
+	}
+
+	i.Version = c.newID("rev")
+	i.ValueDigest = digestValue(passwordValue)
+
+	c.items[uuid] = i
+	c.itemValue[uuid] = passwordValue
+	return &i, nil
+}
+
+func (c *fakeClient) UpdateItemMetadata(ctx context.Context, vaultUUID, uuid, title string) (*model.Item, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i, ok := c.items[uuid]
+	if !ok || i.VaultID != vaultUUID {
+		return nil, fmt.Errorf("item %q not found", uuid)
+	}
+
+	i.Title = title
+	i.Version = c.newID("rev")
+	c.items[uuid] = i
+	return &i, nil
+}
+
+func (c *fakeClient) DeleteItem(ctx context.Context, vaultUUID, uuid string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.items, uuid)
+	delete(c.itemValue, uuid)
+	return nil
+}
+
+func (c *fakeClient) GeneratePasswordValue(ctx context.Context, recipeUUID string, length int64) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if recipeUUID == "" {
+		return c.newID("value"), nil
+	}
+	if _, ok := c.recipes[recipeUUID]; !ok {
+		return "", fmt.Errorf("recipe %q not found", recipeUUID)
+	}
+	return c.newID("recipe-value"), nil
+}
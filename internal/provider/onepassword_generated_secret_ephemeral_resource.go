@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &OnePasswordGeneratedSecretEphemeralResource{}
+
+func NewOnePasswordGeneratedSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &OnePasswordGeneratedSecretEphemeralResource{}
+}
+
+// OnePasswordGeneratedSecretEphemeralResource reads the plaintext of a value
+// created by the onepassword_generated_secret resource, for consumption by
+// downstream resources without it ever being written to Terraform state.
+// Requires Terraform 1.10 or later.
+type OnePasswordGeneratedSecretEphemeralResource struct {
+	client onepassword.Client
+}
+
+// OnePasswordGeneratedSecretEphemeralResourceModel describes the ephemeral
+// resource data model.
+type OnePasswordGeneratedSecretEphemeralResourceModel struct {
+	VaultID types.String `tfsdk:"vault_id"`
+	UUID    types.String `tfsdk:"uuid"`
+	Value   types.String `tfsdk:"value"`
+}
+
+func (r *OnePasswordGeneratedSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_generated_secret"
+}
+
+func (r *OnePasswordGeneratedSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the plaintext value generated by an `onepassword_generated_secret` resource, without ever persisting it to Terraform state.",
+
+		Attributes: map[string]schema.Attribute{
+			"vault_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the vault holding the generated secret.",
+				Required:            true,
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the generated secret, as returned by `onepassword_generated_secret`'s `uuid` attribute.",
+				Required:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The plaintext of the generated value.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *OnePasswordGeneratedSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(onepassword.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected EphemeralResource Configure Type",
+			fmt.Sprintf("Expected onepassword.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OnePasswordGeneratedSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data OnePasswordGeneratedSecretEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := r.client.GetSecretValue(ctx, data.VaultID.ValueString(), data.UUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Generated Secret read error",
+			fmt.Sprintf("Could not read generated secret '%s', got error: %s", data.UUID.ValueString(), err),
+		)
+		return
+	}
+
+	data.Value = types.StringValue(value)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
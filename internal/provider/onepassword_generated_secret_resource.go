@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OnePasswordGeneratedSecretResource{}
+var _ resource.ResourceWithImportState = &OnePasswordGeneratedSecretResource{}
+
+func NewOnePasswordGeneratedSecretResource() resource.Resource {
+	return &OnePasswordGeneratedSecretResource{}
+}
+
+// OnePasswordGeneratedSecretResource generates a value directly inside a
+// vault item and never returns it to Terraform; only a digest and revision
+// of the value are tracked in state. Use the companion
+// "onepassword_generated_secret" ephemeral resource to consume the plaintext
+// without persisting it.
+type OnePasswordGeneratedSecretResource struct {
+	client onepassword.Client
+}
+
+// OnePasswordGeneratedSecretResourceModel describes the resource data model.
+type OnePasswordGeneratedSecretResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	UUID          types.String `tfsdk:"uuid"`
+	VaultID       types.String `tfsdk:"vault_id"`
+	Label         types.String `tfsdk:"label"`
+	RecipeRef     types.String `tfsdk:"recipe_ref"`
+	Length        types.Int64  `tfsdk:"length"`
+	RotateTrigger types.String `tfsdk:"rotate_trigger"`
+	ValueDigest   types.String `tfsdk:"value_digest"`
+	Version       types.String `tfsdk:"version"`
+}
+
+func (r *OnePasswordGeneratedSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_generated_secret"
+}
+
+func (r *OnePasswordGeneratedSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a value directly inside a 1Password vault item. The plaintext value is " +
+			"never returned to Terraform or stored in state; only a digest and revision are tracked. Pair with " +
+			"the `onepassword_generated_secret` ephemeral resource to consume the value in downstream resources " +
+			"without persisting it. Requires a service account backend; not supported with 1Password Connect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The Terraform resource identifier for this secret, in the format `vaults/<vault_id>/items/<uuid>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the vault item holding the generated value.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vault_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the vault the value is generated into.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The title of the vault item holding the generated value.",
+				Required:            true,
+			},
+			"recipe_ref": schema.StringAttribute{
+				MarkdownDescription: "The `ref` of an `onepassword_password_recipe` recorded against this secret. " +
+					"Changing it rotates the generated value. The recipe's policy is not applied: the value is always " +
+					"generated from `length`.",
+				Optional: true,
+			},
+			"length": schema.Int64Attribute{
+				MarkdownDescription: "The length of the generated value. Defaults to `32`. " +
+					"Changing it rotates the generated value.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(32),
+			},
+			"rotate_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value that, when changed, forces the generated value to be rotated.",
+				Optional:            true,
+			},
+			"value_digest": schema.StringAttribute{
+				MarkdownDescription: "A SHA-256 digest of the current value, usable to detect drift without exposing the plaintext.",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The current revision identifier of the vault item. It changes on every write to the item, including a label-only update.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *OnePasswordGeneratedSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(onepassword.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected onepassword.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OnePasswordGeneratedSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OnePasswordGeneratedSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret := &model.GeneratedSecret{
+		VaultID:   plan.VaultID.ValueString(),
+		Label:     plan.Label.ValueString(),
+		RecipeRef: plan.RecipeRef.ValueString(),
+		Length:    plan.Length.ValueInt64(),
+	}
+
+	createdSecret, err := r.client.CreateGeneratedSecret(ctx, secret)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Generated Secret create error",
+			fmt.Sprintf("Error generating secret, got error: %s", err),
+		)
+		return
+	}
+
+	setGeneratedSecretModel(&plan, createdSecret)
+
+	tflog.Trace(ctx, "created a generated secret resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordGeneratedSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OnePasswordGeneratedSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The value itself is never re-read; only its metadata is refreshed.
+	secret, err := r.client.GetGeneratedSecret(ctx, state.VaultID.ValueString(), state.UUID.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"1Password Generated Secret read error",
+			fmt.Sprintf("Could not get generated secret '%s', got error: %s", state.UUID.ValueString(), err),
+		)
+		return
+	}
+
+	state.Label = types.StringValue(secret.Label)
+	state.Version = types.StringValue(secret.Version)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OnePasswordGeneratedSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OnePasswordGeneratedSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotate := plan.RotateTrigger.ValueString() != state.RotateTrigger.ValueString() ||
+		plan.RecipeRef.ValueString() != state.RecipeRef.ValueString() ||
+		plan.Length.ValueInt64() != state.Length.ValueInt64()
+	labelChanged := plan.Label.ValueString() != state.Label.ValueString()
+	wantedLabel := plan.Label
+
+	if rotate {
+		rotatedSecret, err := r.client.RotateSecret(ctx, plan.VaultID.ValueString(), state.UUID.ValueString(), plan.Length.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"1Password Generated Secret rotate error",
+				fmt.Sprintf("Could not rotate generated secret '%s', got error: %s", state.UUID.ValueString(), err),
+			)
+			return
+		}
+		// RotateSecret never touches the vault item's title, so
+		// setGeneratedSecretModel below would otherwise stamp plan.Label
+		// back to the pre-update title; wantedLabel was captured above to
+		// survive that.
+		setGeneratedSecretModel(&plan, rotatedSecret)
+	} else {
+		plan.UUID = state.UUID
+		plan.ID = state.ID
+		plan.ValueDigest = state.ValueDigest
+		plan.Version = state.Version
+	}
+
+	if labelChanged {
+		updatedSecret, err := r.client.UpdateGeneratedSecretMetadata(ctx, plan.VaultID.ValueString(), state.UUID.ValueString(), wantedLabel.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"1Password Generated Secret update error",
+				fmt.Sprintf("Could not update generated secret '%s', got error: %s", state.UUID.ValueString(), err),
+			)
+			return
+		}
+		plan.Label = types.StringValue(updatedSecret.Label)
+		plan.Version = types.StringValue(updatedSecret.Version)
+	} else {
+		plan.Label = wantedLabel
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordGeneratedSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OnePasswordGeneratedSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGeneratedSecret(ctx, state.VaultID.ValueString(), state.UUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Generated Secret delete error",
+			fmt.Sprintf("Could not delete generated secret '%s', got error: %s", state.UUID.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *OnePasswordGeneratedSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vaultID, itemUUID, err := vaultItemIDsFromTerraformID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier in the format 'vaults/<vault_id>/items/<uuid>', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vault_id"), vaultID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), itemUUID)...)
+}
+
+// vaultItemIDsFromTerraformID parses an identifier of the form
+// "vaults/<vault_id>/items/<uuid>" into its vault and item UUIDs. It is
+// shared by every resource that models a vault item (generated secrets,
+// items, ...) since they all import under the same identifier shape.
+func vaultItemIDsFromTerraformID(tfID string) (vaultID, itemUUID string, err error) {
+	elements := strings.Split(tfID, "/")
+	if len(elements) != 4 {
+		return "", "", fmt.Errorf("expected identifier in the format 'vaults/<vault_id>/items/<uuid>', got: %s", tfID)
+	}
+	return elements[1], elements[3], nil
+}
+
+func setGeneratedSecretModel(m *OnePasswordGeneratedSecretResourceModel, secret *model.GeneratedSecret) {
+	m.ID = types.StringValue(fmt.Sprintf("vaults/%s/items/%s", secret.VaultID, secret.ID))
+	m.UUID = types.StringValue(secret.ID)
+	m.Label = types.StringValue(secret.Label)
+	m.Length = types.Int64Value(secret.Length)
+	m.ValueDigest = types.StringValue(secret.ValueDigest)
+	m.Version = types.StringValue(secret.Version)
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestAccGeneratedSecretResourceConnectUnsupported(t *testing.T) {
+	expectedItem := generateDatabaseItem()
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "VaultName",
+		Description: "This vault will be retrieved for testing",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccGeneratedSecretResourceConfig(expectedVault.ID, "Test Secret"),
+				ExpectError: regexp.MustCompile("not supported with 1Password Connect"),
+			},
+		},
+	})
+}
+
+func testAccGeneratedSecretResourceConfig(vaultID, label string) string {
+	return `
+resource "onepassword_generated_secret" "test" {
+  vault_id = "` + vaultID + `"
+  label    = "` + label + `"
+  length   = 24
+}
+`
+}
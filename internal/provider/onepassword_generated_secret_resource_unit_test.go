@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestGeneratedSecretResourceCreateUpdateRotatesOnRecipeChangeAndUpdatesLabelInPlace(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	r := &OnePasswordGeneratedSecretResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	plan := OnePasswordGeneratedSecretResourceModel{
+		VaultID:       types.StringValue("vault-1"),
+		Label:         types.StringValue("Test Secret"),
+		RecipeRef:     types.StringValue("recipes/one"),
+		Length:        types.Int64Value(32),
+		RotateTrigger: types.StringNull(),
+	}
+
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Create(ctx, createReq, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var created OnePasswordGeneratedSecretResourceModel
+	createResp.State.Get(ctx, &created)
+	originalDigest := created.ValueDigest.ValueString()
+
+	// Changing recipe_ref alone (no rotate_trigger bump) must rotate the value.
+	recipeChangedPlan := created
+	recipeChangedPlan.RecipeRef = types.StringValue("recipes/two")
+
+	updateReq := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}, State: tfsdk.State{Schema: schemaResp.Schema}}
+	updateReq.Plan.Set(ctx, &recipeChangedPlan)
+	updateReq.State.Set(ctx, &created)
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Update(ctx, updateReq, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", updateResp.Diagnostics)
+	}
+
+	var afterRecipeChange OnePasswordGeneratedSecretResourceModel
+	updateResp.State.Get(ctx, &afterRecipeChange)
+	if afterRecipeChange.ValueDigest.ValueString() == originalDigest {
+		t.Fatalf("expected recipe_ref change to rotate the value digest")
+	}
+
+	// Changing only the label must call the backend and update the title in
+	// place, without rotating the value.
+	labelChangedPlan := afterRecipeChange
+	labelChangedPlan.Label = types.StringValue("Renamed Secret")
+
+	updateReq2 := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}, State: tfsdk.State{Schema: schemaResp.Schema}}
+	updateReq2.Plan.Set(ctx, &labelChangedPlan)
+	updateReq2.State.Set(ctx, &afterRecipeChange)
+	updateResp2 := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Update(ctx, updateReq2, updateResp2)
+	if updateResp2.Diagnostics.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", updateResp2.Diagnostics)
+	}
+
+	var afterLabelChange OnePasswordGeneratedSecretResourceModel
+	updateResp2.State.Get(ctx, &afterLabelChange)
+	if afterLabelChange.ValueDigest.ValueString() != afterRecipeChange.ValueDigest.ValueString() {
+		t.Fatalf("expected label-only change to leave the value digest untouched")
+	}
+
+	remoteSecret, err := client.GetGeneratedSecret(ctx, "vault-1", afterLabelChange.UUID.ValueString())
+	if err != nil {
+		t.Fatalf("unexpected error reading back secret: %s", err)
+	}
+	if remoteSecret.Label != "Renamed Secret" {
+		t.Fatalf("expected backend title to be updated, got %q", remoteSecret.Label)
+	}
+
+	// Changing the label and rotating the value in the same apply must still
+	// land the label change: RotateSecret's response describes the backend
+	// item's pre-update title, and must not clobber the label the plan asked
+	// for.
+	bothChangedPlan := afterLabelChange
+	bothChangedPlan.Label = types.StringValue("Renamed Again")
+	bothChangedPlan.RotateTrigger = types.StringValue("bump")
+
+	updateReq3 := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}, State: tfsdk.State{Schema: schemaResp.Schema}}
+	updateReq3.Plan.Set(ctx, &bothChangedPlan)
+	updateReq3.State.Set(ctx, &afterLabelChange)
+	updateResp3 := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Update(ctx, updateReq3, updateResp3)
+	if updateResp3.Diagnostics.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", updateResp3.Diagnostics)
+	}
+
+	var afterBothChange OnePasswordGeneratedSecretResourceModel
+	updateResp3.State.Get(ctx, &afterBothChange)
+	if afterBothChange.Label.ValueString() != "Renamed Again" {
+		t.Fatalf("expected label to be updated even when rotating in the same apply, got %q", afterBothChange.Label.ValueString())
+	}
+	if afterBothChange.ValueDigest.ValueString() == afterLabelChange.ValueDigest.ValueString() {
+		t.Fatalf("expected the rotate_trigger change to still rotate the value digest")
+	}
+
+	remoteAfterBoth, err := client.GetGeneratedSecret(ctx, "vault-1", afterBothChange.UUID.ValueString())
+	if err != nil {
+		t.Fatalf("unexpected error reading back secret: %s", err)
+	}
+	if remoteAfterBoth.Label != "Renamed Again" {
+		t.Fatalf("expected backend title to reflect the label set alongside the rotation, got %q", remoteAfterBoth.Label)
+	}
+}
+
+// TestGeneratedSecretResourceRotatePreservesLength guards against a rotation
+// discarding the configured length: RotateSecret's response describes the
+// backend item's current metadata, which never includes length (the
+// plaintext is never read back), so Update must thread the plan's length
+// through rather than letting it fall back to whatever the backend returns.
+func TestGeneratedSecretResourceRotatePreservesLength(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	r := &OnePasswordGeneratedSecretResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	plan := OnePasswordGeneratedSecretResourceModel{
+		VaultID:       types.StringValue("vault-1"),
+		Label:         types.StringValue("Test Secret"),
+		Length:        types.Int64Value(64),
+		RotateTrigger: types.StringNull(),
+	}
+
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Create(ctx, createReq, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var created OnePasswordGeneratedSecretResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.Length.ValueInt64() != 64 {
+		t.Fatalf("expected length 64 after create, got %d", created.Length.ValueInt64())
+	}
+
+	rotatedPlan := created
+	rotatedPlan.RotateTrigger = types.StringValue("bump")
+
+	updateReq := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}, State: tfsdk.State{Schema: schemaResp.Schema}}
+	updateReq.Plan.Set(ctx, &rotatedPlan)
+	updateReq.State.Set(ctx, &created)
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Update(ctx, updateReq, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", updateResp.Diagnostics)
+	}
+
+	var afterRotate OnePasswordGeneratedSecretResourceModel
+	updateResp.State.Get(ctx, &afterRotate)
+	if afterRotate.Length.ValueInt64() != 64 {
+		t.Fatalf("expected length to remain 64 after rotation, got %d", afterRotate.Length.ValueInt64())
+	}
+}
+
+func TestGeneratedSecretEphemeralResourceOpenReadsPlaintext(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	r := &OnePasswordGeneratedSecretEphemeralResource{client: client}
+
+	secret, err := client.CreateGeneratedSecret(ctx, &model.GeneratedSecret{
+		VaultID: "vault-1",
+		Label:   "Test Secret",
+		Length:  32,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating secret: %s", err)
+	}
+
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	config := OnePasswordGeneratedSecretEphemeralResourceModel{
+		VaultID: types.StringValue(secret.VaultID),
+		UUID:    types.StringValue(secret.ID),
+	}
+
+	openReq := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema}}
+	openReq.Config.Set(ctx, &config)
+	openResp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema}}
+
+	r.Open(ctx, openReq, openResp)
+	if openResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected open diagnostics: %v", openResp.Diagnostics)
+	}
+
+	var result OnePasswordGeneratedSecretEphemeralResourceModel
+	openResp.Result.Get(ctx, &result)
+	if result.Value.ValueString() == "" {
+		t.Fatalf("expected the ephemeral resource to return the generated plaintext")
+	}
+
+	expected, err := client.GetSecretValue(ctx, secret.VaultID, secret.ID)
+	if err != nil {
+		t.Fatalf("unexpected error reading secret value: %s", err)
+	}
+	if result.Value.ValueString() != expected {
+		t.Fatalf("expected ephemeral value %q, got %q", expected, result.Value.ValueString())
+	}
+}
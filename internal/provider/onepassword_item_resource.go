@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OnePasswordItemResource{}
+var _ resource.ResourceWithImportState = &OnePasswordItemResource{}
+var _ resource.ResourceWithValidateConfig = &OnePasswordItemResource{}
+
+func NewOnePasswordItemResource() resource.Resource {
+	return &OnePasswordItemResource{}
+}
+
+// OnePasswordItemResource manages a 1Password item with a generated password
+// field. The field's value can come from an inline `length`, or from a
+// `recipe_ref` pointing at an onepassword_password_recipe, in which case the
+// value is shaped by that recipe's character-set (or word-list) policy. The
+// plaintext is never returned to Terraform or stored in state; only a digest
+// and revision are tracked, the same as onepassword_generated_secret.
+type OnePasswordItemResource struct {
+	client onepassword.Client
+}
+
+// OnePasswordItemResourceModel describes the resource data model.
+type OnePasswordItemResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	UUID          types.String `tfsdk:"uuid"`
+	VaultID       types.String `tfsdk:"vault_id"`
+	Title         types.String `tfsdk:"title"`
+	Category      types.String `tfsdk:"category"`
+	RecipeRef     types.String `tfsdk:"recipe_ref"`
+	Length        types.Int64  `tfsdk:"length"`
+	RotateTrigger types.String `tfsdk:"rotate_trigger"`
+	ValueDigest   types.String `tfsdk:"value_digest"`
+	Version       types.String `tfsdk:"version"`
+}
+
+func (r *OnePasswordItemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_item"
+}
+
+func (r *OnePasswordItemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a 1Password item with a generated password field. The plaintext value is " +
+			"never returned to Terraform or stored in state; only a digest and revision are tracked, the same " +
+			"as `onepassword_generated_secret`. Requires a service account backend; not supported with " +
+			"1Password Connect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The Terraform resource identifier for this item, in the format `vaults/<vault_id>/items/<uuid>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the vault item.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vault_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the vault the item is created in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the vault item.",
+				Required:            true,
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "The item's category. Defaults to `LOGIN`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("LOGIN"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recipe_ref": schema.StringAttribute{
+				MarkdownDescription: "The `ref` of an `onepassword_password_recipe` to generate the password field " +
+					"with, instead of `length`. Changing it rotates the generated value.",
+				Optional: true,
+			},
+			"length": schema.Int64Attribute{
+				MarkdownDescription: "The length of the generated password, when `recipe_ref` is not set. Defaults to `32`. " +
+					"Changing it rotates the generated value.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(32),
+			},
+			"rotate_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value that, when changed, forces the password field to be rotated.",
+				Optional:            true,
+			},
+			"value_digest": schema.StringAttribute{
+				MarkdownDescription: "A SHA-256 digest of the current password value, usable to detect drift without exposing the plaintext.",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The current revision identifier of the vault item. It changes on every write to the item, including a title-only update.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *OnePasswordItemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OnePasswordItemResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RecipeRef.IsUnknown() && data.RecipeRef.ValueString() != "" &&
+		!data.Length.IsUnknown() && !data.Length.IsNull() && data.Length.ValueInt64() != 32 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("length"),
+			"Unused Attribute",
+			"`length` is ignored when `recipe_ref` is set; the password is shaped by the recipe's policy instead.",
+		)
+	}
+}
+
+func (r *OnePasswordItemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(onepassword.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected onepassword.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OnePasswordItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OnePasswordItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recipeUUID, ok := r.resolveRecipeRef(ctx, plan.RecipeRef.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	passwordValue, err := r.client.GeneratePasswordValue(ctx, recipeUUID, plan.Length.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Item create error",
+			fmt.Sprintf("Error generating item password, got error: %s", err),
+		)
+		return
+	}
+
+	item := &model.Item{
+		VaultID:  plan.VaultID.ValueString(),
+		Title:    plan.Title.ValueString(),
+		Category: plan.Category.ValueString(),
+	}
+
+	createdItem, err := r.client.CreateItem(ctx, item, passwordValue)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Item create error",
+			fmt.Sprintf("Error creating 1Password item, got error: %s", err),
+		)
+		return
+	}
+
+	setItemModel(&plan, createdItem)
+
+	tflog.Trace(ctx, "created an item resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OnePasswordItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password value itself is never re-read; only its metadata is refreshed.
+	item, err := r.client.GetItem(ctx, state.VaultID.ValueString(), state.UUID.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"1Password Item read error",
+			fmt.Sprintf("Could not get item '%s', got error: %s", state.UUID.ValueString(), err),
+		)
+		return
+	}
+
+	state.Title = types.StringValue(item.Title)
+	state.Version = types.StringValue(item.Version)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OnePasswordItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OnePasswordItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotate := plan.RotateTrigger.ValueString() != state.RotateTrigger.ValueString() ||
+		plan.RecipeRef.ValueString() != state.RecipeRef.ValueString() ||
+		plan.Length.ValueInt64() != state.Length.ValueInt64()
+	titleChanged := plan.Title.ValueString() != state.Title.ValueString()
+	wantedTitle := plan.Title
+
+	if rotate {
+		recipeUUID, ok := r.resolveRecipeRef(ctx, plan.RecipeRef.ValueString(), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+
+		passwordValue, err := r.client.GeneratePasswordValue(ctx, recipeUUID, plan.Length.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"1Password Item rotate error",
+				fmt.Sprintf("Error generating item password, got error: %s", err),
+			)
+			return
+		}
+
+		rotatedItem, err := r.client.RotateItemPassword(ctx, plan.VaultID.ValueString(), state.UUID.ValueString(), passwordValue)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"1Password Item rotate error",
+				fmt.Sprintf("Could not rotate item '%s', got error: %s", state.UUID.ValueString(), err),
+			)
+			return
+		}
+		// RotateItemPassword never touches the item's title, so
+		// setItemModel below would otherwise stamp plan.Title back to the
+		// pre-update title; wantedTitle was captured above to survive that.
+		setItemModel(&plan, rotatedItem)
+	} else {
+		plan.UUID = state.UUID
+		plan.ID = state.ID
+		plan.ValueDigest = state.ValueDigest
+		plan.Version = state.Version
+	}
+
+	if titleChanged {
+		updatedItem, err := r.client.UpdateItemMetadata(ctx, plan.VaultID.ValueString(), state.UUID.ValueString(), wantedTitle.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"1Password Item update error",
+				fmt.Sprintf("Could not update item '%s', got error: %s", state.UUID.ValueString(), err),
+			)
+			return
+		}
+		plan.Title = types.StringValue(updatedItem.Title)
+		plan.Version = types.StringValue(updatedItem.Version)
+	} else {
+		plan.Title = wantedTitle
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OnePasswordItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteItem(ctx, state.VaultID.ValueString(), state.UUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Item delete error",
+			fmt.Sprintf("Could not delete item '%s', got error: %s", state.UUID.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *OnePasswordItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vaultID, itemUUID, err := vaultItemIDsFromTerraformID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier in the format 'vaults/<vault_id>/items/<uuid>', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vault_id"), vaultID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), itemUUID)...)
+}
+
+// resolveRecipeRef parses ref, when non-empty, into the recipe UUID the
+// client understands. It reports a diagnostic and returns ok=false on a
+// malformed ref.
+func (r *OnePasswordItemResource) resolveRecipeRef(ctx context.Context, ref string, diags *diag.Diagnostics) (uuid string, ok bool) {
+	if ref == "" {
+		return "", true
+	}
+
+	uuid, err := passwordRecipeUUIDFromRef(ref)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("recipe_ref"),
+			"Invalid Recipe Reference",
+			err.Error(),
+		)
+		return "", false
+	}
+	return uuid, true
+}
+
+func setItemModel(m *OnePasswordItemResourceModel, item *model.Item) {
+	m.ID = types.StringValue(fmt.Sprintf("vaults/%s/items/%s", item.VaultID, item.ID))
+	m.UUID = types.StringValue(item.ID)
+	m.Title = types.StringValue(item.Title)
+	m.Category = types.StringValue(item.Category)
+	m.ValueDigest = types.StringValue(item.ValueDigest)
+	m.Version = types.StringValue(item.Version)
+}
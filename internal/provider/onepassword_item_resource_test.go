@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestAccItemResourceConnectUnsupported(t *testing.T) {
+	expectedItem := generateDatabaseItem()
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "VaultName",
+		Description: "This vault will be retrieved for testing",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccItemResourceConfig(expectedVault.ID, "Test Item"),
+				ExpectError: regexp.MustCompile("not supported with 1Password Connect"),
+			},
+		},
+	})
+}
+
+func testAccItemResourceConfig(vaultID, title string) string {
+	return `
+resource "onepassword_item" "test" {
+  vault_id = "` + vaultID + `"
+  title    = "` + title + `"
+  length   = 24
+}
+`
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestItemResourceCreateUpdateRotatesOnRecipeChangeAndUpdatesTitleInPlace(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	r := &OnePasswordItemResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	recipe, err := client.CreateRecipe(ctx, &model.PasswordRecipe{Name: "Recipe One", Length: 20})
+	if err != nil {
+		t.Fatalf("unexpected error creating recipe: %s", err)
+	}
+	recipeRef := passwordRecipeRef(recipe.ID)
+
+	plan := OnePasswordItemResourceModel{
+		VaultID:       types.StringValue("vault-1"),
+		Title:         types.StringValue("Test Item"),
+		Category:      types.StringValue("LOGIN"),
+		RecipeRef:     types.StringValue(recipeRef),
+		Length:        types.Int64Value(32),
+		RotateTrigger: types.StringNull(),
+	}
+
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Create(ctx, createReq, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var created OnePasswordItemResourceModel
+	createResp.State.Get(ctx, &created)
+	originalDigest := created.ValueDigest.ValueString()
+
+	// Changing recipe_ref alone (no rotate_trigger bump) must rotate the value.
+	secondRecipe, err := client.CreateRecipe(ctx, &model.PasswordRecipe{Name: "Recipe Two", Length: 20})
+	if err != nil {
+		t.Fatalf("unexpected error creating second recipe: %s", err)
+	}
+	recipeChangedPlan := created
+	recipeChangedPlan.RecipeRef = types.StringValue(passwordRecipeRef(secondRecipe.ID))
+
+	updateReq := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}, State: tfsdk.State{Schema: schemaResp.Schema}}
+	updateReq.Plan.Set(ctx, &recipeChangedPlan)
+	updateReq.State.Set(ctx, &created)
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Update(ctx, updateReq, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", updateResp.Diagnostics)
+	}
+
+	var afterRecipeChange OnePasswordItemResourceModel
+	updateResp.State.Get(ctx, &afterRecipeChange)
+	if afterRecipeChange.ValueDigest.ValueString() == originalDigest {
+		t.Fatalf("expected recipe_ref change to rotate the value digest")
+	}
+
+	// Changing the title and rotating the value in the same apply must still
+	// land the title change: RotateItemPassword's response describes the
+	// backend item's pre-update title, and must not clobber the title the
+	// plan asked for.
+	bothChangedPlan := afterRecipeChange
+	bothChangedPlan.Title = types.StringValue("Renamed Item")
+	bothChangedPlan.RotateTrigger = types.StringValue("bump")
+
+	updateReq2 := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}, State: tfsdk.State{Schema: schemaResp.Schema}}
+	updateReq2.Plan.Set(ctx, &bothChangedPlan)
+	updateReq2.State.Set(ctx, &afterRecipeChange)
+	updateResp2 := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Update(ctx, updateReq2, updateResp2)
+	if updateResp2.Diagnostics.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", updateResp2.Diagnostics)
+	}
+
+	var afterBothChange OnePasswordItemResourceModel
+	updateResp2.State.Get(ctx, &afterBothChange)
+	if afterBothChange.Title.ValueString() != "Renamed Item" {
+		t.Fatalf("expected title to be updated even when rotating in the same apply, got %q", afterBothChange.Title.ValueString())
+	}
+	if afterBothChange.ValueDigest.ValueString() == afterRecipeChange.ValueDigest.ValueString() {
+		t.Fatalf("expected the rotate_trigger change to still rotate the value digest")
+	}
+
+	remoteAfterBoth, err := client.GetItem(ctx, "vault-1", afterBothChange.UUID.ValueString())
+	if err != nil {
+		t.Fatalf("unexpected error reading back item: %s", err)
+	}
+	if remoteAfterBoth.Title != "Renamed Item" {
+		t.Fatalf("expected backend title to reflect the title set alongside the rotation, got %q", remoteAfterBoth.Title)
+	}
+}
+
+func TestItemResourceInvalidRecipeRefReturnsDiagnostic(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	r := &OnePasswordItemResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	plan := OnePasswordItemResourceModel{
+		VaultID:   types.StringValue("vault-1"),
+		Title:     types.StringValue("Test Item"),
+		Category:  types.StringValue("LOGIN"),
+		RecipeRef: types.StringValue("not-a-valid-ref"),
+		Length:    types.Int64Value(32),
+	}
+
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Create(ctx, createReq, createResp)
+	if !createResp.Diagnostics.HasError() {
+		t.Fatalf("expected an error diagnostic for a malformed recipe_ref")
+	}
+}
@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OnePasswordPasswordRecipeResource{}
+var _ resource.ResourceWithImportState = &OnePasswordPasswordRecipeResource{}
+var _ resource.ResourceWithValidateConfig = &OnePasswordPasswordRecipeResource{}
+
+func NewOnePasswordPasswordRecipeResource() resource.Resource {
+	return &OnePasswordPasswordRecipeResource{}
+}
+
+// OnePasswordPasswordRecipeResource defines the resource implementation.
+//
+// A recipe is materialized as a reusable object in 1Password, identified by
+// its computed "ref" attribute. onepassword_item's recipe_ref attribute
+// consumes that ref to shape a generated password field, and the item rotates
+// its password when recipe_ref is changed to another ref. A ref is derived
+// from the recipe's UUID alone, so updating a recipe in place keeps its ref
+// and does not rotate the items that reference it.
+type OnePasswordPasswordRecipeResource struct {
+	client onepassword.Client
+}
+
+// OnePasswordPasswordRecipeResourceModel describes the resource data model.
+type OnePasswordPasswordRecipeResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	UUID             types.String `tfsdk:"uuid"`
+	Name             types.String `tfsdk:"name"`
+	Ref              types.String `tfsdk:"ref"`
+	Length           types.Int64  `tfsdk:"length"`
+	IncludeLetters   types.Bool   `tfsdk:"include_letters"`
+	IncludeDigits    types.Bool   `tfsdk:"include_digits"`
+	IncludeSymbols   types.Bool   `tfsdk:"include_symbols"`
+	SymbolCharset    types.String `tfsdk:"symbol_charset"`
+	ExcludeAmbiguous types.Bool   `tfsdk:"exclude_ambiguous"`
+	WordCount        types.Int64  `tfsdk:"word_count"`
+	Separator        types.String `tfsdk:"separator"`
+}
+
+func (r *OnePasswordPasswordRecipeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_recipe"
+}
+
+func (r *OnePasswordPasswordRecipeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a reusable 1Password password recipe, identified by its computed `ref` " +
+			"attribute. Pass `ref` as the `recipe_ref` of an `onepassword_item` to generate that item's password " +
+			"field from this recipe's policy instead of a plain length. Requires a service account backend; not " +
+			"supported with 1Password Connect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The Terraform resource identifier for this recipe, in the format `password_recipes/<uuid>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the password recipe.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "An opaque reference identifying this recipe. Pass it as the `recipe_ref` " +
+					"of an `onepassword_item` password field to generate that field's value from this recipe.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the password recipe.",
+				Required:            true,
+			},
+			"length": schema.Int64Attribute{
+				MarkdownDescription: "The length of generated passwords. Defaults to `32`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(32),
+			},
+			"include_letters": schema.BoolAttribute{
+				MarkdownDescription: "Whether generated passwords include letters. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"include_digits": schema.BoolAttribute{
+				MarkdownDescription: "Whether generated passwords include digits. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"include_symbols": schema.BoolAttribute{
+				MarkdownDescription: "Whether generated passwords include symbols. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"symbol_charset": schema.StringAttribute{
+				MarkdownDescription: "The set of symbol characters allowed when `include_symbols` is `true`. Defaults to 1Password's standard symbol set.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"exclude_ambiguous": schema.BoolAttribute{
+				MarkdownDescription: "Whether to exclude visually ambiguous characters (e.g. `0`/`O`, `1`/`l`). Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"word_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of words in a memorable password. When set, the recipe generates a word-list password " +
+					"instead of a character-based one and `length`, `include_letters`, `include_digits`, `include_symbols`, " +
+					"`symbol_charset` and `exclude_ambiguous` are ignored. Defaults to `0` (character-based).",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+			},
+			"separator": schema.StringAttribute{
+				MarkdownDescription: "The separator placed between words in a memorable password. Only used when `word_count` is greater than `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("-"),
+			},
+		},
+	}
+}
+
+func (r *OnePasswordPasswordRecipeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OnePasswordPasswordRecipeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.WordCount.IsUnknown() && data.WordCount.ValueInt64() > 0 && !data.Length.IsUnknown() && !data.Length.IsNull() && data.Length.ValueInt64() != 32 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("length"),
+			"Unused Attribute",
+			"`length` is ignored for word-list recipes (`word_count` > 0).",
+		)
+	}
+}
+
+func (r *OnePasswordPasswordRecipeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(onepassword.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected onepassword.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OnePasswordPasswordRecipeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OnePasswordPasswordRecipeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recipe := passwordRecipeFromModel(&plan)
+
+	createdRecipe, err := r.client.CreateRecipe(ctx, recipe)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Password Recipe create error",
+			fmt.Sprintf("Error creating 1Password password recipe, got error: %s", err),
+		)
+		return
+	}
+
+	setPasswordRecipeModel(&plan, createdRecipe)
+
+	tflog.Trace(ctx, "created a password recipe resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordPasswordRecipeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OnePasswordPasswordRecipeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recipe, err := r.client.GetRecipe(ctx, state.UUID.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"1Password Password Recipe read error",
+			fmt.Sprintf("Could not get password recipe '%s', got error: %s", state.UUID.ValueString(), err),
+		)
+		return
+	}
+
+	setPasswordRecipeModel(&state, recipe)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OnePasswordPasswordRecipeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OnePasswordPasswordRecipeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recipe := passwordRecipeFromModel(&plan)
+	recipe.ID = plan.UUID.ValueString()
+
+	updatedRecipe, err := r.client.UpdateRecipe(ctx, recipe)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Password Recipe update error",
+			fmt.Sprintf("Could not update password recipe '%s', got error: %s", plan.UUID.ValueString(), err),
+		)
+		return
+	}
+
+	setPasswordRecipeModel(&plan, updatedRecipe)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordPasswordRecipeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OnePasswordPasswordRecipeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRecipe(ctx, state.UUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Password Recipe delete error",
+			fmt.Sprintf("Could not delete password recipe '%s', got error: %s", state.UUID.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *OnePasswordPasswordRecipeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	recipeUUID := req.ID
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("password_recipes/%s", recipeUUID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), recipeUUID)...)
+}
+
+func passwordRecipeFromModel(m *OnePasswordPasswordRecipeResourceModel) *model.PasswordRecipe {
+	return &model.PasswordRecipe{
+		Name:             m.Name.ValueString(),
+		Length:           m.Length.ValueInt64(),
+		IncludeLetters:   m.IncludeLetters.ValueBool(),
+		IncludeDigits:    m.IncludeDigits.ValueBool(),
+		IncludeSymbols:   m.IncludeSymbols.ValueBool(),
+		SymbolCharset:    m.SymbolCharset.ValueString(),
+		ExcludeAmbiguous: m.ExcludeAmbiguous.ValueBool(),
+		WordCount:        m.WordCount.ValueInt64(),
+		Separator:        m.Separator.ValueString(),
+	}
+}
+
+func setPasswordRecipeModel(m *OnePasswordPasswordRecipeResourceModel, recipe *model.PasswordRecipe) {
+	m.ID = types.StringValue(fmt.Sprintf("password_recipes/%s", recipe.ID))
+	m.UUID = types.StringValue(recipe.ID)
+	m.Ref = types.StringValue(passwordRecipeRef(recipe.ID))
+	m.Name = types.StringValue(recipe.Name)
+	m.Length = types.Int64Value(recipe.Length)
+	m.IncludeLetters = types.BoolValue(recipe.IncludeLetters)
+	m.IncludeDigits = types.BoolValue(recipe.IncludeDigits)
+	m.IncludeSymbols = types.BoolValue(recipe.IncludeSymbols)
+	m.SymbolCharset = types.StringValue(recipe.SymbolCharset)
+	m.ExcludeAmbiguous = types.BoolValue(recipe.ExcludeAmbiguous)
+	m.WordCount = types.Int64Value(recipe.WordCount)
+	m.Separator = types.StringValue(recipe.Separator)
+}
+
+// passwordRecipeRef returns the opaque reference this recipe is identified
+// by, consumed by onepassword_item's recipe_ref attribute.
+func passwordRecipeRef(uuid string) string {
+	return strings.Join([]string{"onepassword_password_recipe", uuid}, "://")
+}
+
+// passwordRecipeUUIDFromRef parses the UUID out of a recipe_ref produced by
+// passwordRecipeRef.
+func passwordRecipeUUIDFromRef(ref string) (string, error) {
+	prefix := "onepassword_password_recipe://"
+	if !strings.HasPrefix(ref, prefix) {
+		return "", fmt.Errorf("expected recipe_ref in the format %q<uuid>, got: %s", prefix, ref)
+	}
+	return strings.TrimPrefix(ref, prefix), nil
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestAccPasswordRecipeResourceConnectUnsupported(t *testing.T) {
+	expectedItem := generateDatabaseItem()
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "VaultName",
+		Description: "This vault will be retrieved for testing",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccPasswordRecipeResourceConfig("Test Recipe"),
+				ExpectError: regexp.MustCompile("not supported with 1Password Connect"),
+			},
+		},
+	})
+}
+
+func testAccPasswordRecipeResourceConfig(name string) string {
+	return `
+resource "onepassword_password_recipe" "test" {
+  name              = "` + name + `"
+  length            = 24
+  include_letters   = true
+  include_digits    = true
+  include_symbols   = false
+}
+`
+}
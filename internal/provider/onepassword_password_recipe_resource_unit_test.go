@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPasswordRecipeResourceCreateReadUpdateDelete(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	r := &OnePasswordPasswordRecipeResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	plan := OnePasswordPasswordRecipeResourceModel{
+		Name:             types.StringValue("Test Recipe"),
+		Length:           types.Int64Value(24),
+		IncludeLetters:   types.BoolValue(true),
+		IncludeDigits:    types.BoolValue(true),
+		IncludeSymbols:   types.BoolValue(false),
+		SymbolCharset:    types.StringValue(""),
+		ExcludeAmbiguous: types.BoolValue(false),
+		WordCount:        types.Int64Value(0),
+		Separator:        types.StringValue("-"),
+	}
+
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Create(ctx, createReq, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var created OnePasswordPasswordRecipeResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.UUID.ValueString() == "" {
+		t.Fatalf("expected a UUID to be assigned on create")
+	}
+	if created.Ref.ValueString() == "" {
+		t.Fatalf("expected a ref to be assigned on create")
+	}
+
+	// Read back what was just created.
+	readReq := resource.ReadRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	readReq.State.Set(ctx, &created)
+	readResp := &resource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Read(ctx, readReq, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var afterRead OnePasswordPasswordRecipeResourceModel
+	readResp.State.Get(ctx, &afterRead)
+	if afterRead.Length.ValueInt64() != 24 {
+		t.Fatalf("expected length 24 after read, got %d", afterRead.Length.ValueInt64())
+	}
+
+	// Update the recipe's length.
+	updatedPlan := afterRead
+	updatedPlan.Length = types.Int64Value(40)
+
+	updateReq := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	updateReq.Plan.Set(ctx, &updatedPlan)
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Update(ctx, updateReq, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", updateResp.Diagnostics)
+	}
+
+	var afterUpdate OnePasswordPasswordRecipeResourceModel
+	updateResp.State.Get(ctx, &afterUpdate)
+	if afterUpdate.Length.ValueInt64() != 40 {
+		t.Fatalf("expected length 40 after update, got %d", afterUpdate.Length.ValueInt64())
+	}
+
+	remote, err := client.GetRecipe(ctx, afterUpdate.UUID.ValueString())
+	if err != nil {
+		t.Fatalf("unexpected error reading back recipe: %s", err)
+	}
+	if remote.Length != 40 {
+		t.Fatalf("expected backend recipe length to be updated, got %d", remote.Length)
+	}
+
+	// Delete removes the recipe entirely.
+	deleteReq := resource.DeleteRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	deleteReq.State.Set(ctx, &afterUpdate)
+	deleteResp := &resource.DeleteResponse{}
+
+	r.Delete(ctx, deleteReq, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", deleteResp.Diagnostics)
+	}
+
+	if _, err := client.GetRecipe(ctx, afterUpdate.UUID.ValueString()); err == nil {
+		t.Fatalf("expected recipe to be gone after delete")
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// validVaultPermissions are the permissions that can be granted to a group or
+// user on a vault.
+var validVaultPermissions = []model.VaultPermission{
+	model.VaultPermissionViewItems,
+	model.VaultPermissionCreateItems,
+	model.VaultPermissionEditItems,
+	model.VaultPermissionArchiveItems,
+	model.VaultPermissionDeleteItems,
+	model.VaultPermissionViewAndCopyPasswords,
+	model.VaultPermissionViewItemHistory,
+	model.VaultPermissionImportItems,
+	model.VaultPermissionExportItems,
+	model.VaultPermissionCopyAndShareItems,
+	model.VaultPermissionPrintItems,
+	model.VaultPermissionManageVault,
+}
+
+// vaultPermissionsValidator ensures every value in a permissions set is a
+// permission 1Password actually recognizes.
+type vaultPermissionsValidator struct{}
+
+func (v vaultPermissionsValidator) Description(ctx context.Context) string {
+	return "value must be a valid vault permission"
+}
+
+func (v vaultPermissionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v vaultPermissionsValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var permissions []string
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, permission := range permissions {
+		if !isValidVaultPermission(permission) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Vault Permission",
+				fmt.Sprintf("%q is not a recognized 1Password vault permission", permission),
+			)
+		}
+	}
+}
+
+func isValidVaultPermission(permission string) bool {
+	for _, valid := range validVaultPermissions {
+		if string(valid) == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// vaultPermissionsFromSet converts a permissions set attribute into the
+// model's permission slice, appending any conversion failure to diags.
+func vaultPermissionsFromSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []model.VaultPermission {
+	var raw []string
+	diags.Append(set.ElementsAs(ctx, &raw, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	permissions := make([]model.VaultPermission, 0, len(raw))
+	for _, p := range raw {
+		permissions = append(permissions, model.VaultPermission(p))
+	}
+	return permissions
+}
+
+// vaultPermissionsToStrings converts the model's permission slice back into
+// plain strings for storing in a set attribute.
+func vaultPermissionsToStrings(permissions []model.VaultPermission) []string {
+	out := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		out = append(out, string(p))
+	}
+	return out
+}
+
+// vaultAccessTerraformID returns the Terraform resource identifier for a
+// vault access grant.
+func vaultAccessTerraformID(access *model.VaultAccess) string {
+	switch access.SubjectType {
+	case model.VaultAccessSubjectTypeGroup:
+		return fmt.Sprintf("vaults/%s/groups/%s", access.VaultID, access.SubjectID)
+	default:
+		return fmt.Sprintf("vaults/%s/users/%s", access.VaultID, access.SubjectID)
+	}
+}
+
+// vaultAccessIDsFromTerraformID parses a Terraform resource identifier of the
+// form "vaults/<vault_id>/groups/<group_id>" or "vaults/<vault_id>/users/<user_id>"
+// back into its vault and subject UUIDs.
+func vaultAccessIDsFromTerraformID(tfID string) (vaultID, subjectID string, err error) {
+	elements := strings.Split(tfID, "/")
+	if len(elements) != 4 {
+		return "", "", fmt.Errorf("expected identifier in the format 'vaults/<vault_id>/groups|users/<subject_id>', got: %s", tfID)
+	}
+	return elements[1], elements[3], nil
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OnePasswordVaultAccessUserResource{}
+var _ resource.ResourceWithImportState = &OnePasswordVaultAccessUserResource{}
+
+func NewOnePasswordVaultAccessUserResource() resource.Resource {
+	return &OnePasswordVaultAccessUserResource{}
+}
+
+// OnePasswordVaultAccessUserResource defines the resource implementation.
+type OnePasswordVaultAccessUserResource struct {
+	client onepassword.Client
+}
+
+// OnePasswordVaultAccessUserResourceModel describes the resource data model.
+type OnePasswordVaultAccessUserResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	VaultID     types.String `tfsdk:"vault_id"`
+	UserID      types.String `tfsdk:"user_id"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+func (r *OnePasswordVaultAccessUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_access_user"
+}
+
+func (r *OnePasswordVaultAccessUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants a user a set of permissions on a 1Password Vault. Requires a service account backend; not supported with 1Password Connect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The Terraform resource identifier for this grant, in the format `vaults/<vault_id>/users/<user_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vault_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the vault to grant access to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the user to grant access to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "The set of permissions granted to the user on the vault. One or more of " +
+					"`view_items`, `create_items`, `edit_items`, `archive_items`, `delete_items`, " +
+					"`view_and_copy_passwords`, `view_item_history`, `import_items`, `export_items`, " +
+					"`copy_and_share_items`, `print_items`, `manage_vault`.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					vaultPermissionsValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *OnePasswordVaultAccessUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(onepassword.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected onepassword.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OnePasswordVaultAccessUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OnePasswordVaultAccessUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions := vaultPermissionsFromSet(ctx, plan.Permissions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access := &model.VaultAccess{
+		VaultID:     plan.VaultID.ValueString(),
+		SubjectID:   plan.UserID.ValueString(),
+		SubjectType: model.VaultAccessSubjectTypeUser,
+		Permissions: permissions,
+	}
+
+	grantedAccess, err := r.client.GrantVaultAccess(ctx, access)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Vault Access User create error",
+			fmt.Sprintf("Error granting user access to vault, got error: %s", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(vaultAccessTerraformID(grantedAccess))
+	setVaultAccessUserPermissions(ctx, &plan, grantedAccess, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "created a vault access user resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordVaultAccessUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OnePasswordVaultAccessUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access, err := r.findVaultAccess(ctx, state.VaultID.ValueString(), state.UserID.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"1Password Vault Access User read error",
+			fmt.Sprintf("Could not get vault access for user '%s', got error: %s", state.UserID.ValueString(), err),
+		)
+		return
+	}
+	if access == nil {
+		// The permission grant was removed out-of-band.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(vaultAccessTerraformID(access))
+	setVaultAccessUserPermissions(ctx, &state, access, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OnePasswordVaultAccessUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OnePasswordVaultAccessUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions := vaultPermissionsFromSet(ctx, plan.Permissions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access := &model.VaultAccess{
+		VaultID:     plan.VaultID.ValueString(),
+		SubjectID:   plan.UserID.ValueString(),
+		SubjectType: model.VaultAccessSubjectTypeUser,
+		Permissions: permissions,
+	}
+
+	updatedAccess, err := r.client.GrantVaultAccess(ctx, access)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Vault Access User update error",
+			fmt.Sprintf("Could not update vault access for user '%s', got error: %s", plan.UserID.ValueString(), err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(vaultAccessTerraformID(updatedAccess))
+	setVaultAccessUserPermissions(ctx, &plan, updatedAccess, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OnePasswordVaultAccessUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OnePasswordVaultAccessUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RevokeVaultAccess(ctx, state.VaultID.ValueString(), state.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"1Password Vault Access User delete error",
+			fmt.Sprintf("Could not revoke vault access for user '%s', got error: %s", state.UserID.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *OnePasswordVaultAccessUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vaultID, userID, err := vaultAccessIDsFromTerraformID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier in the format 'vaults/<vault_id>/users/<user_id>', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vault_id"), vaultID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
+
+func (r *OnePasswordVaultAccessUserResource) findVaultAccess(ctx context.Context, vaultID, userID string) (*model.VaultAccess, error) {
+	access, err := r.client.ListVaultAccess(ctx, vaultID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range access {
+		if access[i].SubjectType == model.VaultAccessSubjectTypeUser && access[i].SubjectID == userID {
+			return &access[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func setVaultAccessUserPermissions(ctx context.Context, state *OnePasswordVaultAccessUserResourceModel, access *model.VaultAccess, diags *diag.Diagnostics) {
+	permissions, d := types.SetValueFrom(ctx, types.StringType, vaultPermissionsToStrings(access.Permissions))
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	state.VaultID = types.StringValue(access.VaultID)
+	state.UserID = types.StringValue(access.SubjectID)
+	state.Permissions = permissions
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+func TestAccVaultAccessUserResourceConnectUnsupported(t *testing.T) {
+	expectedItem := generateDatabaseItem()
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "VaultName",
+		Description: "This vault will be retrieved for testing",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccVaultAccessUserResourceConfig(expectedVault.ID, "user-test-uuid"),
+				ExpectError: regexp.MustCompile("not supported with 1Password Connect"),
+			},
+		},
+	})
+}
+
+func testAccVaultAccessUserResourceConfig(vaultID, userID string) string {
+	return `
+resource "onepassword_vault_access_user" "test" {
+  vault_id = "` + vaultID + `"
+  user_id  = "` + userID + `"
+  permissions = ["view_items", "create_items"]
+}
+`
+}
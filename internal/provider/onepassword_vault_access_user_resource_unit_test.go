@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// newVaultAccessUserTestResource returns a user vault-access resource backed
+// by a fresh fakeClient, along with its schema, for driving Create, Read,
+// Update and Delete directly without a Terraform test harness.
+func newVaultAccessUserTestResource(ctx context.Context, t *testing.T) (*OnePasswordVaultAccessUserResource, *fakeClient, resource.SchemaResponse) {
+	t.Helper()
+
+	client := newFakeClient()
+	r := &OnePasswordVaultAccessUserResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	return r, client, schemaResp
+}
+
+func TestVaultAccessUserResourceCreateReadUpdateDelete(t *testing.T) {
+	ctx := context.Background()
+	r, client, schemaResp := newVaultAccessUserTestResource(ctx, t)
+
+	plan := OnePasswordVaultAccessUserResourceModel{
+		VaultID:     types.StringValue("vault-1"),
+		UserID:      types.StringValue("user-1"),
+		Permissions: permissionsSet(t, ctx, "view_items", "create_items"),
+	}
+
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Create(ctx, createReq, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var created OnePasswordVaultAccessUserResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueString() != "vaults/vault-1/users/user-1" {
+		t.Fatalf("unexpected id after create: %s", created.ID.ValueString())
+	}
+
+	// Update to a different permission set; Update should call GrantVaultAccess
+	// again (not revoke-then-recreate) and leave exactly one grant behind.
+	updatedPlan := created
+	updatedPlan.Permissions = permissionsSet(t, ctx, "view_items")
+
+	updateReq := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	updateReq.Plan.Set(ctx, &updatedPlan)
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Update(ctx, updateReq, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", updateResp.Diagnostics)
+	}
+
+	access, err := client.ListVaultAccess(ctx, "vault-1")
+	if err != nil {
+		t.Fatalf("unexpected error listing vault access: %s", err)
+	}
+	if len(access) != 1 {
+		t.Fatalf("expected exactly one grant to remain after update, got %d", len(access))
+	}
+
+	// Delete removes the grant entirely.
+	deleteReq := resource.DeleteRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	deleteReq.State.Set(ctx, &updatedPlan)
+	deleteResp := &resource.DeleteResponse{}
+
+	r.Delete(ctx, deleteReq, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", deleteResp.Diagnostics)
+	}
+
+	access, err = client.ListVaultAccess(ctx, "vault-1")
+	if err != nil {
+		t.Fatalf("unexpected error listing vault access: %s", err)
+	}
+	if len(access) != 0 {
+		t.Fatalf("expected no grants to remain after delete, got %d", len(access))
+	}
+}
+
+func TestVaultAccessUserResourceReadDetectsOutOfBandRevoke(t *testing.T) {
+	ctx := context.Background()
+	r, client, schemaResp := newVaultAccessUserTestResource(ctx, t)
+
+	_, err := client.GrantVaultAccess(ctx, &model.VaultAccess{
+		VaultID:     "vault-1",
+		SubjectID:   "user-1",
+		SubjectType: model.VaultAccessSubjectTypeUser,
+		Permissions: []model.VaultPermission{model.VaultPermissionViewItems},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error granting vault access: %s", err)
+	}
+
+	state := OnePasswordVaultAccessUserResourceModel{
+		ID:          types.StringValue("vaults/vault-1/users/user-1"),
+		VaultID:     types.StringValue("vault-1"),
+		UserID:      types.StringValue("user-1"),
+		Permissions: permissionsSet(t, ctx, "view_items"),
+	}
+
+	// Simulate the grant being revoked outside of Terraform.
+	if err := client.RevokeVaultAccess(ctx, "vault-1", "user-1"); err != nil {
+		t.Fatalf("unexpected error revoking vault access: %s", err)
+	}
+
+	readReq := resource.ReadRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	readReq.State.Set(ctx, &state)
+	readResp := &resource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Read(ctx, readReq, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+	if !readResp.State.Raw.IsNull() {
+		t.Fatalf("expected state to be removed after an out-of-band revoke")
+	}
+}
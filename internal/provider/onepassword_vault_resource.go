@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -37,6 +38,41 @@ type OnePasswordVaultResourceModel struct {
 	UUID        types.String `tfsdk:"uuid"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
+	Template    types.String `tfsdk:"template"`
+}
+
+// validVaultTemplates are the templates a vault can be created from.
+var validVaultTemplates = []string{"personal", "private", "employee", "shared", "custom"}
+
+// vaultTemplateValidator ensures the template attribute, when set, is one of
+// the templates 1Password supports.
+type vaultTemplateValidator struct{}
+
+func (v vaultTemplateValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(validVaultTemplates, ", "))
+}
+
+func (v vaultTemplateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v vaultTemplateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	for _, valid := range validVaultTemplates {
+		if value == valid {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Vault Template",
+		fmt.Sprintf("%q is not a recognized 1Password vault template, must be one of: %s", value, strings.Join(validVaultTemplates, ", ")),
+	)
 }
 
 func (r *OnePasswordVaultResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,6 +108,23 @@ func (r *OnePasswordVaultResource) Schema(ctx context.Context, req resource.Sche
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"template": schema.StringAttribute{
+				MarkdownDescription: "The template to seed the vault's default item categories and permissions from. " +
+					"One of `personal`, `private`, `employee`, `shared`, `custom`. The `personal` template is not " +
+					"allowed on business accounts. 1Password does not persist which template a vault was created " +
+					"from; after creation this is re-inferred from the vault's current category set on every read, " +
+					"so it is best-effort and informational only, and never forces the vault to be replaced. " +
+					"Requires a service account backend; not supported with 1Password Connect.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					vaultTemplateValidator{},
+				},
+			},
 		},
 	}
 }
@@ -105,7 +158,26 @@ func (r *OnePasswordVaultResource) Create(ctx context.Context, req resource.Crea
 		Description: plan.Description.ValueString(),
 	}
 
-	createdVault, err := r.client.CreateVault(ctx, vault)
+	templateName := plan.Template.ValueString()
+
+	var createdVault *model.Vault
+	var err error
+	if templateName != "" {
+		if templateName == "personal" {
+			accountType, acctErr := r.client.AccountType(ctx)
+			if acctErr == nil && accountType == "business" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("template"),
+					"Invalid Vault Template",
+					"The `personal` template is not allowed on business accounts.",
+				)
+				return
+			}
+		}
+		createdVault, err = r.client.CreateVaultFromTemplate(ctx, vault, templateName)
+	} else {
+		createdVault, err = r.client.CreateVault(ctx, vault)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"1Password Vault create error",
@@ -118,6 +190,11 @@ func (r *OnePasswordVaultResource) Create(ctx context.Context, req resource.Crea
 	plan.UUID = types.StringValue(createdVault.ID)
 	plan.Name = types.StringValue(createdVault.Name)
 	plan.Description = types.StringValue(createdVault.Description)
+	// plan.Template is set explicitly (rather than left as whatever the plan
+	// carried in) so that final state always holds a known value, even when
+	// the plan carried Unknown; Read() re-derives it from the vault's
+	// category set on every subsequent refresh regardless.
+	plan.Template = types.StringValue(templateName)
 
 	tflog.Trace(ctx, "created a vault resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -148,6 +225,14 @@ func (r *OnePasswordVaultResource) Read(ctx context.Context, req resource.ReadRe
 	state.UUID = types.StringValue(vault.ID)
 	state.Name = types.StringValue(vault.Name)
 	state.Description = types.StringValue(vault.Description)
+	if vault.Template != "" {
+		state.Template = types.StringValue(vault.Template)
+	} else if !state.Template.IsNull() {
+		// The vault's category set no longer matches any known template.
+		// "template" is Computed with no RequiresReplace, so updating it here
+		// is informational only and never forces the vault to be replaced.
+		state.Template = types.StringNull()
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -31,6 +31,28 @@ func TestAccVaultResourceConnectUnsupported(t *testing.T) {
 	})
 }
 
+func TestAccVaultResourceInvalidTemplate(t *testing.T) {
+	expectedItem := generateDatabaseItem()
+	expectedVault := model.Vault{
+		ID:          expectedItem.VaultID,
+		Name:        "VaultName",
+		Description: "This vault will be retrieved for testing",
+	}
+
+	testServer := setupTestServer(expectedItem, expectedVault, t)
+	defer testServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(testServer.URL) + testAccVaultResourceConfigWithTemplate("Test Vault", "A test vault", "nonsense"),
+				ExpectError: regexp.MustCompile("not a recognized 1Password vault template"),
+			},
+		},
+	})
+}
+
 func testAccVaultResourceConfig(name, description string) string {
 	return `
 resource "onepassword_vault" "test" {
@@ -39,3 +61,13 @@ resource "onepassword_vault" "test" {
 }
 `
 }
+
+func testAccVaultResourceConfigWithTemplate(name, description, template string) string {
+	return `
+resource "onepassword_vault" "test" {
+  name        = "` + name + `"
+  description = "` + description + `"
+  template    = "` + template + `"
+}
+`
+}
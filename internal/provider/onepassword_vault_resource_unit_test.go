@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestVaultResourceTemplateDoesNotRequireReplace guards against the template
+// attribute regressing back into a destructive plan modifier: it is
+// re-inferred from the vault's category set on every read and must never
+// force the vault to be destroyed and recreated over that drift.
+func TestVaultResourceTemplateDoesNotRequireReplace(t *testing.T) {
+	ctx := context.Background()
+	r := &OnePasswordVaultResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	attr, ok := schemaResp.Schema.Attributes["template"]
+	if !ok {
+		t.Fatalf("expected a template attribute in the schema")
+	}
+
+	stringAttr, ok := attr.(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("expected template to be a StringAttribute, got %T", attr)
+	}
+
+	requiresReplaceDescription := stringplanmodifier.RequiresReplace().Description(ctx)
+	for _, modifier := range stringAttr.PlanModifiers {
+		if modifier.Description(ctx) == requiresReplaceDescription {
+			t.Fatalf("template must not use RequiresReplace; out-of-band category drift would destroy and recreate the vault")
+		}
+	}
+
+	if !stringAttr.Computed {
+		t.Fatalf("expected template to be Computed so Read can correct it without forcing a diff")
+	}
+}
+
+// TestVaultResourceCreateWithoutTemplateWritesKnownState guards against
+// "template" reaching Create() as Unknown (the value an Optional+Computed
+// attribute with no Default takes on when the user omits it from config) and
+// being written straight into final state, which Terraform rejects with
+// "Provider produced inconsistent result after apply".
+func TestVaultResourceCreateWithoutTemplateWritesKnownState(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	r := &OnePasswordVaultResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	plan := OnePasswordVaultResourceModel{
+		Name:        types.StringValue("Test Vault"),
+		Description: types.StringValue(""),
+		Template:    types.StringUnknown(),
+	}
+
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Create(ctx, createReq, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var created OnePasswordVaultResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.Template.IsUnknown() {
+		t.Fatalf("expected template to be a known value in final state, got Unknown")
+	}
+	if created.Template.ValueString() != "" {
+		t.Fatalf("expected template to be empty when omitted from config, got %q", created.Template.ValueString())
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OnePasswordVaultTemplateDataSource{}
+
+func NewOnePasswordVaultTemplateDataSource() datasource.DataSource {
+	return &OnePasswordVaultTemplateDataSource{}
+}
+
+// OnePasswordVaultTemplateDataSource reads the default item categories and
+// permission matrix 1Password seeds a vault with when it is created from a
+// given template. The template definitions are static, so this data source
+// does not require a 1Password client and works the same with both a
+// service account and 1Password Connect.
+type OnePasswordVaultTemplateDataSource struct{}
+
+// OnePasswordVaultTemplateDataSourceModel describes the data source data model.
+type OnePasswordVaultTemplateDataSourceModel struct {
+	Name               types.String `tfsdk:"name"`
+	DefaultCategories  types.List   `tfsdk:"default_categories"`
+	DefaultPermissions types.List   `tfsdk:"default_permissions"`
+}
+
+func (d *OnePasswordVaultTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_template"
+}
+
+func (d *OnePasswordVaultTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the default item categories and permission matrix for a 1Password vault template, " +
+			"usable to bootstrap vaults consistently without hand-crafting `onepassword_vault_access_group`/`onepassword_vault_access_user` grants.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the vault template. One of `personal`, `private`, `employee`, `shared`, `custom`.",
+				Required:            true,
+			},
+			"default_categories": schema.ListAttribute{
+				MarkdownDescription: "The item categories the template seeds a new vault with.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"default_permissions": schema.ListAttribute{
+				MarkdownDescription: "The permissions the template grants the vault's creator.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *OnePasswordVaultTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OnePasswordVaultTemplateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	template, ok := model.VaultTemplates[name]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Unknown Vault Template",
+			fmt.Sprintf("%q is not a recognized 1Password vault template, must be one of: %s", name, strings.Join(validVaultTemplates, ", ")),
+		)
+		return
+	}
+
+	categories, diags := types.ListValueFrom(ctx, types.StringType, template.DefaultCategories)
+	resp.Diagnostics.Append(diags...)
+	permissions, diags := types.ListValueFrom(ctx, types.StringType, vaultPermissionsToStrings(template.DefaultPermissions))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.DefaultCategories = categories
+	data.DefaultPermissions = permissions
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword"
+)
+
+// Ensure OnePasswordProvider satisfies the provider.Provider interface.
+var _ provider.Provider = &OnePasswordProvider{}
+var _ provider.ProviderWithEphemeralResources = &OnePasswordProvider{}
+
+// OnePasswordProvider defines the provider implementation.
+type OnePasswordProvider struct {
+	// version is set to the provider version on release, "dev" when the
+	// provider is built and run locally, and "test" when running acceptance
+	// testing.
+	version string
+}
+
+// OnePasswordProviderModel describes the provider configuration data model.
+type OnePasswordProviderModel struct {
+	ConnectHost         types.String `tfsdk:"connect_host"`
+	ConnectToken        types.String `tfsdk:"connect_token"`
+	ServiceAccountToken types.String `tfsdk:"service_account_token"`
+}
+
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &OnePasswordProvider{version: version}
+	}
+}
+
+func (p *OnePasswordProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "onepassword"
+	resp.Version = p.version
+}
+
+func (p *OnePasswordProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Interact with secrets stored in 1Password.",
+		Attributes: map[string]schema.Attribute{
+			"connect_host": schema.StringAttribute{
+				MarkdownDescription: "The HTTP(S) URL of a 1Password Connect server.",
+				Optional:            true,
+			},
+			"connect_token": schema.StringAttribute{
+				MarkdownDescription: "A valid token for your 1Password Connect server.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"service_account_token": schema.StringAttribute{
+				MarkdownDescription: "A valid token for your 1Password Service Account.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (p *OnePasswordProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data OnePasswordProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var client onepassword.Client
+	if !data.ServiceAccountToken.IsNull() {
+		sdkClient, err := onepassword.NewSDKClient(ctx, data.ServiceAccountToken.ValueString(), p.userAgent())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to create 1Password client", err.Error())
+			return
+		}
+		client = sdkClient
+	} else {
+		client = onepassword.NewConnectClient(data.ConnectHost.ValueString(), data.ConnectToken.ValueString(), p.userAgent())
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+func (p *OnePasswordProvider) userAgent() string {
+	return "terraform-provider-onepassword/" + p.version
+}
+
+func (p *OnePasswordProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewOnePasswordVaultResource,
+		NewOnePasswordVaultAccessGroupResource,
+		NewOnePasswordVaultAccessUserResource,
+		NewOnePasswordPasswordRecipeResource,
+		NewOnePasswordGeneratedSecretResource,
+		NewOnePasswordItemResource,
+	}
+}
+
+func (p *OnePasswordProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewOnePasswordVaultTemplateDataSource,
+	}
+}
+
+func (p *OnePasswordProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewOnePasswordGeneratedSecretEphemeralResource,
+	}
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/1Password/terraform-provider-onepassword/v2/internal/onepassword/model"
+)
+
+// vaultTerraformID returns the Terraform resource identifier for a vault, in
+// the format expected by ImportState and stored as the "id" attribute.
+func vaultTerraformID(vault *model.Vault) string {
+	return fmt.Sprintf("vaults/%s", vault.ID)
+}
+
+// isNotFoundError reports whether err represents a "resource does not exist"
+// response from a 1Password backend.
+func isNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var notFound interface{ NotFound() bool }
+	if errors.As(err, &notFound) {
+		return notFound.NotFound()
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "not found")
+}